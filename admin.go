@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/utils"
+	"github.com/google/uuid"
+	"golang.org/x/term"
+	"io"
+	"os"
+	"strings"
+)
+
+const userUsage = `usage: user <command> [flags]
+
+commands:
+  create -email E -username U [-role R] [-verified]
+               create a user, with the role reader by default. The password is read
+               from BLOG_USER_PASSWORD or from stdin (without echo on a terminal)
+  promote -email E -role R
+               change the role of the user
+  disable -email E
+               disable the user and revoke all the sessions of the user
+  enable -email E
+               enable the disabled user`
+
+// userPasswordEnv is the environment variable with the password of the created user
+const userPasswordEnv = "BLOG_USER_PASSWORD"
+
+const categoryUsage = `usage: category merge -from A -into B
+
+  move all the posts of the category A to the category B and delete A`
+
+const tagUsage = `usage: tag prune
+
+  delete the tags that are not added to any post`
+
+const sessionUsage = `usage: session revoke -email E [-id ID]
+
+  revoke the session ID (with the sessions rotated from it),
+  or all the sessions of the user if ID is not given`
+
+// adminCommand - an admin subcommand, which operates on the data through db.Store
+type adminCommand func(ctx context.Context, store db.Store, args []string) error
+
+// runAdminCommand connects to the database and runs the admin subcommand
+func runAdminCommand(config utils.Config, command adminCommand, args []string) error {
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		return fmt.Errorf("cannot connect to the db: %w", err)
+	}
+	defer conn.Close()
+
+	return command(context.Background(), db.NewStore(conn), args)
+}
+
+// runUserCommand creates, promotes, disables and enables users
+func runUserCommand(ctx context.Context, store db.Store, args []string) error {
+	if len(args) == 0 {
+		return errors.New(userUsage)
+	}
+
+	command, args := args[0], args[1:]
+	flags := newFlagSet("user " + command)
+	email := flags.String("email", "", "email of the user")
+
+	switch command {
+	case "create":
+		username := flags.String("username", "", "username of the user")
+		role := flags.String("role", utils.ReaderRole, "role of the user")
+		verified := flags.Bool("verified", false, "mark the email as verified")
+		err := parseFlags(flags, userUsage, args, "email", "username")
+		if err != nil {
+			return err
+		}
+
+		if !utils.IsSupportedRole(*role) {
+			return fmt.Errorf("unsupported role %q", *role)
+		}
+
+		password, err := readPassword(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		hashedPassword, err := utils.HashPassword(password)
+		if err != nil {
+			return fmt.Errorf("cannot hash the password: %w", err)
+		}
+
+		user, err := store.CreateUserWithRole(ctx, db.CreateUserWithRoleParams{
+			Username:        *username,
+			Email:           *email,
+			HashedPassword:  hashedPassword,
+			Role:            db.UserRole(*role),
+			IsEmailVerified: *verified,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create the user: %w", err)
+		}
+
+		fmt.Printf("created user %s (%s) with the role %s\n", user.Email, user.Username, user.Role)
+	case "promote":
+		role := flags.String("role", "", "new role of the user")
+		err := parseFlags(flags, userUsage, args, "email", "role")
+		if err != nil {
+			return err
+		}
+
+		if !utils.IsSupportedRole(*role) {
+			return fmt.Errorf("unsupported role %q", *role)
+		}
+
+		user, err := store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+			Email: *email,
+			Role:  db.UserRole(*role),
+		})
+		if err != nil {
+			return userError(*email, err)
+		}
+
+		fmt.Printf("user %s has the role %s\n", user.Email, user.Role)
+	case "disable":
+		err := parseFlags(flags, userUsage, args, "email")
+		if err != nil {
+			return err
+		}
+
+		result, err := store.DisableUserTx(ctx, db.DisableUserTxParams{Email: *email})
+		if err != nil {
+			return userError(*email, err)
+		}
+
+		fmt.Printf("user %s is disabled, all the sessions are revoked\n", result.User.Email)
+	case "enable":
+		err := parseFlags(flags, userUsage, args, "email")
+		if err != nil {
+			return err
+		}
+
+		user, err := store.SetUserDisabled(ctx, db.SetUserDisabledParams{
+			Email:      *email,
+			IsDisabled: false,
+		})
+		if err != nil {
+			return userError(*email, err)
+		}
+
+		fmt.Printf("user %s is enabled\n", user.Email)
+	default:
+		return errors.New(userUsage)
+	}
+
+	return nil
+}
+
+// runCategoryCommand merges categories
+func runCategoryCommand(ctx context.Context, store db.Store, args []string) error {
+	if len(args) == 0 || args[0] != "merge" {
+		return errors.New(categoryUsage)
+	}
+
+	flags := newFlagSet("category merge")
+	from := flags.String("from", "", "name of the category to merge")
+	into := flags.String("into", "", "name of the category to merge into")
+	err := parseFlags(flags, categoryUsage, args[1:], "from", "into")
+	if err != nil {
+		return err
+	}
+
+	result, err := store.MergeCategoriesTx(ctx, db.MergeCategoriesTxParams{
+		FromName: *from,
+		IntoName: *into,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("category not found")
+		}
+		return fmt.Errorf("cannot merge the categories: %w", err)
+	}
+
+	fmt.Printf("moved %d posts to the category %s, deleted the category %s\n", result.MovedPosts, result.Category.Name, *from)
+	return nil
+}
+
+// runTagCommand prunes the unused tags
+func runTagCommand(ctx context.Context, store db.Store, args []string) error {
+	if len(args) != 1 || args[0] != "prune" {
+		return errors.New(tagUsage)
+	}
+
+	names, err := store.DeleteUnusedTags(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot delete the unused tags: %w", err)
+	}
+
+	fmt.Printf("deleted %d unused tags", len(names))
+	if len(names) > 0 {
+		fmt.Printf(": %s", strings.Join(names, ", "))
+	}
+	fmt.Println()
+	return nil
+}
+
+// runSessionCommand revokes sessions
+func runSessionCommand(ctx context.Context, store db.Store, args []string) error {
+	if len(args) == 0 || args[0] != "revoke" {
+		return errors.New(sessionUsage)
+	}
+
+	flags := newFlagSet("session revoke")
+	email := flags.String("email", "", "email of the user")
+	id := flags.String("id", "", "ID of the session")
+	err := parseFlags(flags, sessionUsage, args[1:], "email")
+	if err != nil {
+		return err
+	}
+
+	if *id == "" {
+		err = store.BlockAllSessions(ctx, *email)
+		if err != nil {
+			return fmt.Errorf("cannot revoke the sessions: %w", err)
+		}
+
+		fmt.Printf("revoked all the sessions of %s\n", *email)
+		return nil
+	}
+
+	sessionID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("invalid session ID %q", *id)
+	}
+
+	revoked, err := store.BlockSession(ctx, db.BlockSessionParams{
+		Email: *email,
+		ID:    sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot revoke the session: %w", err)
+	}
+	if revoked == 0 {
+		return fmt.Errorf("session %s of %s not found", sessionID, *email)
+	}
+
+	fmt.Printf("revoked %d sessions of %s\n", revoked, *email)
+	return nil
+}
+
+// newFlagSet creates a flag set that returns the errors instead of printing them and exiting
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {}
+	return flags
+}
+
+// parseFlags parses the flags and checks that the required ones are set.
+// The errors end with the usage of the command.
+func parseFlags(flags *flag.FlagSet, usage string, args []string, required ...string) error {
+	err := flags.Parse(args)
+	if err != nil {
+		return fmt.Errorf("%s: %w\n\n%s", flags.Name(), err, usage)
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%s: unexpected arguments %v\n\n%s", flags.Name(), flags.Args(), usage)
+	}
+
+	for _, name := range required {
+		if flags.Lookup(name).Value.String() == "" {
+			return fmt.Errorf("%s: -%s is required\n\n%s", flags.Name(), name, usage)
+		}
+	}
+
+	return nil
+}
+
+// readPassword returns the password of a new user from BLOG_USER_PASSWORD or from the first line
+// of the input, so it is not visible in the process list and the shell history like a flag.
+// A password typed in a terminal is not echoed.
+func readPassword(input *os.File) (string, error) {
+	if password := os.Getenv(userPasswordEnv); password != "" {
+		return password, nil
+	}
+
+	var password string
+	if term.IsTerminal(int(input.Fd())) {
+		fmt.Fprint(os.Stderr, "password: ")
+		typed, err := term.ReadPassword(int(input.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("cannot read the password: %w", err)
+		}
+		password = string(typed)
+	} else {
+		line, err := bufio.NewReader(input).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("cannot read the password: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	if password == "" {
+		return "", fmt.Errorf("the password is required, set %s or write it to stdin", userPasswordEnv)
+	}
+
+	return password, nil
+}
+
+// userError returns a readable error for a user that does not exist
+func userError(email string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("user %s not found", email)
+	}
+
+	return err
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		required []string
+		errMsg   string
+	}{
+		{
+			name:     "OK",
+			args:     []string{"-email", "user@example.com", "-role", utils.AdminRole},
+			required: []string{"email", "role"},
+		},
+		{
+			name:     "Missing Required Flag",
+			args:     []string{"-email", "user@example.com"},
+			required: []string{"email", "role"},
+			errMsg:   "test: -role is required",
+		},
+		{
+			name:     "Empty Required Flag",
+			args:     []string{"-email", ""},
+			required: []string{"email"},
+			errMsg:   "test: -email is required",
+		},
+		{
+			name:   "Unknown Flag",
+			args:   []string{"-password", "secret"},
+			errMsg: "test: flag provided but not defined: -password",
+		},
+		{
+			name:   "Unexpected Arguments",
+			args:   []string{"-email", "user@example.com", "extra"},
+			errMsg: "test: unexpected arguments [extra]",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			flags := newFlagSet("test")
+			flags.String("email", "", "")
+			flags.String("role", "", "")
+
+			err := parseFlags(flags, "usage", tc.args, tc.required...)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.errMsg+"\n\nusage")
+		})
+	}
+}
+
+// TestRunUserCommand tests the validation of the user command, which fails before the store is used
+func TestRunUserCommand(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []string
+		errMsg string
+	}{
+		{
+			name:   "No Command",
+			args:   []string{},
+			errMsg: userUsage,
+		},
+		{
+			name:   "Unknown Command",
+			args:   []string{"delete", "-email", "user@example.com"},
+			errMsg: userUsage,
+		},
+		{
+			name:   "Create Without Username",
+			args:   []string{"create", "-email", "user@example.com"},
+			errMsg: "user create: -username is required\n\n" + userUsage,
+		},
+		{
+			name:   "Create With Password Flag",
+			args:   []string{"create", "-email", "user@example.com", "-username", "user", "-password", "secret"},
+			errMsg: "user create: flag provided but not defined: -password\n\n" + userUsage,
+		},
+		{
+			name:   "Create With Unsupported Role",
+			args:   []string{"create", "-email", "user@example.com", "-username", "user", "-role", "owner"},
+			errMsg: `unsupported role "owner"`,
+		},
+		{
+			name:   "Promote Without Role",
+			args:   []string{"promote", "-email", "user@example.com"},
+			errMsg: "user promote: -role is required\n\n" + userUsage,
+		},
+		{
+			name:   "Promote With Unsupported Role",
+			args:   []string{"promote", "-email", "user@example.com", "-role", "owner"},
+			errMsg: `unsupported role "owner"`,
+		},
+		{
+			name:   "Disable Without Email",
+			args:   []string{"disable"},
+			errMsg: "user disable: -email is required\n\n" + userUsage,
+		},
+		{
+			name:   "Enable With Arguments",
+			args:   []string{"enable", "-email", "user@example.com", "now"},
+			errMsg: "user enable: unexpected arguments [now]\n\n" + userUsage,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := runUserCommand(context.Background(), nil, tc.args)
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestRunSessionCommand(t *testing.T) {
+	err := runSessionCommand(context.Background(), nil, []string{"revoke"})
+	require.EqualError(t, err, "session revoke: -email is required\n\n"+sessionUsage)
+
+	err = runSessionCommand(context.Background(), nil, []string{"revoke", "-email", "user@example.com", "-id", "1"})
+	require.EqualError(t, err, `invalid session ID "1"`)
+
+	err = runSessionCommand(context.Background(), nil, []string{"list"})
+	require.EqualError(t, err, sessionUsage)
+}
+
+func TestRunCategoryCommand(t *testing.T) {
+	err := runCategoryCommand(context.Background(), nil, []string{"merge", "-from", "go"})
+	require.EqualError(t, err, "category merge: -into is required\n\n"+categoryUsage)
+
+	err = runCategoryCommand(context.Background(), nil, []string{"rename"})
+	require.EqualError(t, err, categoryUsage)
+}
+
+func TestReadPassword(t *testing.T) {
+	// writeInput returns a file (not a terminal) with the input
+	writeInput := func(t *testing.T, input string) *os.File {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		_, err = w.WriteString(input)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		t.Cleanup(func() { _ = r.Close() })
+		return r
+	}
+
+	t.Run("From Input", func(t *testing.T) {
+		t.Setenv(userPasswordEnv, "")
+		password, err := readPassword(writeInput(t, "secret password\r\nnext line\n"))
+		require.NoError(t, err)
+		require.Equal(t, "secret password", password)
+	})
+
+	t.Run("From Input Without Newline", func(t *testing.T) {
+		t.Setenv(userPasswordEnv, "")
+		password, err := readPassword(writeInput(t, "secret"))
+		require.NoError(t, err)
+		require.Equal(t, "secret", password)
+	})
+
+	t.Run("From Environment", func(t *testing.T) {
+		t.Setenv(userPasswordEnv, "env-secret")
+		password, err := readPassword(writeInput(t, "secret\n"))
+		require.NoError(t, err)
+		require.Equal(t, "env-secret", password)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Setenv(userPasswordEnv, "")
+		_, err := readPassword(writeInput(t, "\n"))
+		require.EqualError(t, err, "the password is required, set BLOG_USER_PASSWORD or write it to stdin")
+	})
+}
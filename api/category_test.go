@@ -39,7 +39,7 @@ func TestCreateCategoryAPI(t *testing.T) {
 				"name": category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -58,7 +58,7 @@ func TestCreateCategoryAPI(t *testing.T) {
 				"name": category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -76,7 +76,7 @@ func TestCreateCategoryAPI(t *testing.T) {
 				"name": category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -88,13 +88,30 @@ func TestCreateCategoryAPI(t *testing.T) {
 				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
+		{
+			name: "Forbidden",
+			body: gin.H{
+				"name": category.Name,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Name",
 			body: gin.H{
 				"name": "123Invalid$#",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -152,7 +169,7 @@ func TestDeleteCategoryAPI(t *testing.T) {
 			name:         "OK",
 			categoryName: category.Name,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -168,7 +185,7 @@ func TestDeleteCategoryAPI(t *testing.T) {
 			name:         "Not Found",
 			categoryName: category.Name,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -184,7 +201,7 @@ func TestDeleteCategoryAPI(t *testing.T) {
 			name:         "Internal Server Error",
 			categoryName: category.Name,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -196,11 +213,26 @@ func TestDeleteCategoryAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name:         "Forbidden",
+			categoryName: category.Name,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name:         "Invalid Name",
 			categoryName: "$@#^!",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -360,7 +392,7 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				"new_name": newName,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				params := db.UpdateCategoryParams{
@@ -384,7 +416,7 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				"new_name": newName,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -403,7 +435,7 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				"new_name": newName,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -415,6 +447,24 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Forbidden",
+			body: gin.H{
+				"old_name": category.Name,
+				"new_name": newName,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Name",
 			body: gin.H{
@@ -422,7 +472,7 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				"new_name": "12%  __",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -440,7 +490,7 @@ func TestUpdateCategoryAPI(t *testing.T) {
 				"new_name": newName,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AdminRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -5,18 +5,49 @@ import (
 	"errors"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/lib/pq"
 	"net/http"
+	"time"
 )
 
+type commentResponse struct {
+	ID        int64     `json:"id"`
+	Content   string    `json:"content"`
+	UserID    int32     `json:"user_id"`
+	PostID    int32     `json:"post_id"`
+	ParentID  *int64    `json:"parent_id"`
+	IsDeleted bool      `json:"is_deleted"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// newCommentResponse converts db.Comment to commentResponse
+func newCommentResponse(comment db.Comment) commentResponse {
+	return commentResponse{
+		ID:        comment.ID,
+		Content:   comment.Content,
+		UserID:    comment.UserID,
+		PostID:    comment.PostID,
+		ParentID:  nullInt64ToPointer(comment.ParentID),
+		IsDeleted: comment.IsDeleted,
+		Status:    string(comment.Status),
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
 type createCommentRequest struct {
-	Content string `json:"content" binding:"required"`
-	PostID  int32  `json:"post_id" binding:"required,min=1"`
+	Content  string `json:"content" binding:"required"`
+	PostID   int32  `json:"post_id" binding:"required,min=1"`
+	ParentID *int64 `json:"parent_id" binding:"omitempty,min=1"`
 }
 
 // createComment creates a comment for a post. As a comment author
-// sets the authenticated user
+// sets the authenticated user. If parent_id is set, the comment
+// is a reply to the comment with this id. Comments that break
+// the moderation rules are not published until a moderator approves them.
+// Posts that are not published can be commented only by their authors.
 func (server *Server) createComment(ctx *gin.Context) {
 	var request createCommentRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
@@ -31,12 +62,71 @@ func (server *Server) createComment(ctx *gin.Context) {
 		return
 	}
 
+	// posts that are not published can be commented only by their authors
+	post, err := server.store.GetMinimalPostData(ctx, int64(request.PostID))
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == sql.ErrNoRows || (post.Status != db.PostStatusPublished && post.AuthorID != int32(authUser.ID)) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("post not found")))
+		return
+	}
+
 	params := db.CreateCommentParams{
 		Content: request.Content,
 		UserID:  int32(authUser.ID),
 		PostID:  request.PostID,
 	}
 
+	if request.ParentID != nil {
+		parent, err := server.store.GetComment(ctx, *request.ParentID)
+		if err != nil && err != sql.ErrNoRows {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		// comments waiting for the moderation cannot be replied to
+		if err == sql.ErrNoRows || parent.Status != db.CommentStatusApproved {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("parent comment not found")))
+			return
+		}
+
+		if parent.PostID != request.PostID {
+			err := errors.New("parent comment belongs to a different post")
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		if parent.IsDeleted {
+			err := errors.New("cannot reply to a deleted comment")
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		params.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+
+	rules := server.config.CommentRules()
+	var stats utils.CommenterStats
+	if rules.NeedsHistory() {
+		row, err := server.store.GetCommenterStats(ctx, db.GetCommenterStatsParams{
+			UserID: int32(authUser.ID),
+			Since:  time.Now().Add(-rules.RateWindow),
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		stats = utils.CommenterStats{
+			ApprovedComments: row.ApprovedComments,
+			RecentComments:   row.RecentComments,
+		}
+	}
+	status, reason := rules.Check(request.Content, stats)
+	params.Status = db.CommentStatus(status)
+	params.ModerationReason = reason
+
 	comment, err := server.store.CreateComment(ctx, params)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
@@ -51,7 +141,7 @@ func (server *Server) createComment(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, comment)
+	ctx.JSON(http.StatusCreated, newCommentResponse(comment))
 }
 
 type deleteCommentRequest struct {
@@ -80,6 +170,11 @@ func (server *Server) deleteComment(ctx *gin.Context) {
 		return
 	}
 
+	if comment.IsDeleted {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("comment has been deleted")))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	authUser, err := server.store.GetUser(ctx, authPayload.Email)
 	if err != nil {
@@ -87,14 +182,15 @@ func (server *Server) deleteComment(ctx *gin.Context) {
 		return
 	}
 
-	// check if the authenticated user is the author
-	if comment.UserID != int32(authUser.ID) {
+	// check if the authenticated user is the author or a moderator
+	if comment.UserID != int32(authUser.ID) && !utils.HasRole(string(authUser.Role), utils.ModeratorRole) {
 		err := errors.New("comment does not belong to the authenticated user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
 	}
 
-	err = server.store.DeleteComment(ctx, request.ID)
+	// comments with replies are replaced with a placeholder
+	_, err = server.store.DeleteCommentTx(ctx, request.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -133,6 +229,11 @@ func (server *Server) updateComment(ctx *gin.Context) {
 		return
 	}
 
+	if comment.IsDeleted {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("comment has been deleted")))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	authUser, err := server.store.GetUser(ctx, authPayload.Email)
 	if err != nil {
@@ -158,13 +259,19 @@ func (server *Server) updateComment(ctx *gin.Context) {
 		Content: request.Content,
 	}
 
+	// the new content goes back to the moderation queue if it breaks the rules
+	if status, reason := server.config.CommentRules().CheckContent(request.Content); status != utils.CommentStatusApproved {
+		params.Status = db.NullCommentStatus{CommentStatus: db.CommentStatus(status), Valid: true}
+		params.ModerationReason = sql.NullString{String: reason, Valid: true}
+	}
+
 	updatedComment, err := server.store.UpdateComment(ctx, params)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, updatedComment)
+	ctx.JSON(http.StatusOK, newCommentResponse(updatedComment))
 }
 
 type listCommentsUriRequest struct {
@@ -203,5 +310,241 @@ func (server *Server) listComments(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, comments)
+	res := make([]commentListItem, len(comments))
+	for i, comment := range comments {
+		res[i] = commentListItem{
+			ID:        comment.ID,
+			Content:   comment.Content,
+			UserID:    comment.UserID,
+			Username:  comment.Username,
+			ParentID:  nullInt64ToPointer(comment.ParentID),
+			IsDeleted: comment.IsDeleted,
+			CreatedAt: comment.CreatedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type commentListItem struct {
+	ID        int64     `json:"id"`
+	Content   string    `json:"content"`
+	UserID    int32     `json:"user_id"`
+	Username  string    `json:"username"`
+	ParentID  *int64    `json:"parent_id"`
+	IsDeleted bool      `json:"is_deleted"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type commentTreeNode struct {
+	commentListItem
+	ReplyCount int64              `json:"reply_count"`
+	Replies    []*commentTreeNode `json:"replies,omitempty"`
+}
+
+// listCommentTree lists top-level comments of a post, the newest first,
+// with all their replies nested under them (the oldest reply first).
+// Pagination applies only to the top-level comments.
+func (server *Server) listCommentTree(ctx *gin.Context) {
+	var uriRequest listCommentsUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request listCommentsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rows, err := server.store.ListCommentTree(ctx, db.ListCommentTreeParams{
+		PostID: uriRequest.PostID,
+		Limit:  request.PageSize,
+		Offset: (request.Page - 1) * request.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, buildCommentTree(rows))
+}
+
+// buildCommentTree nests the comments under their parents. Rows have to be
+// ordered by depth, so every parent comes before its replies.
+func buildCommentTree(rows []db.ListCommentTreeRow) []*commentTreeNode {
+	roots := make([]*commentTreeNode, 0)
+	nodes := make(map[int64]*commentTreeNode, len(rows))
+
+	for _, row := range rows {
+		node := &commentTreeNode{
+			commentListItem: commentListItem{
+				ID:        row.ID,
+				Content:   row.Content,
+				UserID:    row.UserID,
+				Username:  row.Username,
+				ParentID:  nullInt64ToPointer(row.ParentID),
+				IsDeleted: row.IsDeleted,
+				CreatedAt: row.CreatedAt,
+			},
+			ReplyCount: row.ReplyCount,
+		}
+		nodes[row.ID] = node
+
+		if row.Depth == 0 {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[row.ParentID.Int64]; ok {
+			parent.Replies = append(parent.Replies, node)
+		}
+	}
+
+	return roots
+}
+
+type listCommentRepliesUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// listCommentReplies lists direct replies of a comment, the oldest first.
+// Every reply has its own reply count, so the clients can load deeper levels on demand.
+func (server *Server) listCommentReplies(ctx *gin.Context) {
+	var uriRequest listCommentRepliesUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request listCommentsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	replies, err := server.store.ListCommentReplies(ctx, db.ListCommentRepliesParams{
+		ParentID: sql.NullInt64{Int64: uriRequest.ID, Valid: true},
+		Limit:    request.PageSize,
+		Offset:   (request.Page - 1) * request.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]*commentTreeNode, len(replies))
+	for i, reply := range replies {
+		res[i] = &commentTreeNode{
+			commentListItem: commentListItem{
+				ID:        reply.ID,
+				Content:   reply.Content,
+				UserID:    reply.UserID,
+				Username:  reply.Username,
+				ParentID:  nullInt64ToPointer(reply.ParentID),
+				IsDeleted: reply.IsDeleted,
+				CreatedAt: reply.CreatedAt,
+			},
+			ReplyCount: reply.ReplyCount,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// nullInt64ToPointer converts sql.NullInt64 into an optional int64
+func nullInt64ToPointer(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
+
+type listModerationQueueRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending spam rejected"`
+	Page     int32  `form:"page" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+type moderationQueueItem struct {
+	ID               int64     `json:"id"`
+	Content          string    `json:"content"`
+	UserID           int32     `json:"user_id"`
+	Username         string    `json:"username"`
+	PostID           int32     `json:"post_id"`
+	ParentID         *int64    `json:"parent_id"`
+	Status           string    `json:"status"`
+	ModerationReason string    `json:"moderation_reason"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// listModerationQueue lists comments with the given moderation status
+// (pending by default), the oldest first. Available to moderators.
+func (server *Server) listModerationQueue(ctx *gin.Context) {
+	var request listModerationQueueRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if request.Status == "" {
+		request.Status = utils.CommentStatusPending
+	}
+
+	comments, err := server.store.ListModerationQueue(ctx, db.ListModerationQueueParams{
+		Status: db.CommentStatus(request.Status),
+		Limit:  request.PageSize,
+		Offset: (request.Page - 1) * request.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]moderationQueueItem, len(comments))
+	for i, comment := range comments {
+		res[i] = moderationQueueItem{
+			ID:               comment.ID,
+			Content:          comment.Content,
+			UserID:           comment.UserID,
+			Username:         comment.Username,
+			PostID:           comment.PostID,
+			ParentID:         nullInt64ToPointer(comment.ParentID),
+			Status:           string(comment.Status),
+			ModerationReason: comment.ModerationReason,
+			CreatedAt:        comment.CreatedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type moderateCommentsRequest struct {
+	IDs    []int64 `json:"ids" binding:"required,min=1,max=100,dive,min=1"`
+	Status string  `json:"status" binding:"required,oneof=approved rejected spam"`
+}
+
+type moderateCommentsResponse struct {
+	UpdatedIDs []int64 `json:"updated_ids"`
+}
+
+// moderateComments approves or rejects comments from the moderation queue in bulk.
+// Comments that are not in the queue are skipped. Available to moderators.
+func (server *Server) moderateComments(ctx *gin.Context) {
+	var request moderateCommentsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	updatedIDs, err := server.store.UpdateCommentsStatus(ctx, db.UpdateCommentsStatusParams{
+		Status: db.CommentStatus(request.Status),
+		Ids:    request.IDs,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, moderateCommentsResponse{UpdatedIDs: updatedIDs})
 }
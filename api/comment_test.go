@@ -23,11 +23,24 @@ import (
 func TestCreateCommentAPI(t *testing.T) {
 	randomUser, _ := generateRandomUser(t)
 	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+	publishedPost := db.GetMinimalPostDataRow{ID: post.ID, AuthorID: post.AuthorID, Status: db.PostStatusPublished}
 	comment := db.Comment{
 		Content: "test comment",
 		UserID:  int32(randomUser.ID),
 		PostID:  utils.RandomInt(1, 100),
 	}
+	parent := db.GetCommentRow{
+		ID:     int64(utils.RandomInt(1, 100)),
+		UserID: int32(randomUser.ID),
+		PostID: int32(post.ID),
+		Status: db.CommentStatusApproved,
+	}
+	reply := db.Comment{
+		Content:  comment.Content,
+		UserID:   int32(randomUser.ID),
+		PostID:   int32(post.ID),
+		ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+	}
 
 	testCases := []struct {
 		name          string
@@ -43,13 +56,17 @@ func TestCreateCommentAPI(t *testing.T) {
 				"post_id": post.ID,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
 					Times(1).
 					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
 				store.EXPECT().
 					CreateComment(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -67,7 +84,7 @@ func TestCreateCommentAPI(t *testing.T) {
 				"post_id": post.ID,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -89,13 +106,17 @@ func TestCreateCommentAPI(t *testing.T) {
 				"post_id": post.ID,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
 					Times(1).
 					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
 				store.EXPECT().
 					CreateComment(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -112,13 +133,17 @@ func TestCreateCommentAPI(t *testing.T) {
 				"post_id": post.ID,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
 					Times(1).
 					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
 				store.EXPECT().
 					CreateComment(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -128,6 +153,218 @@ func TestCreateCommentAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "OK Reply",
+			body: gin.H{
+				"content":   comment.Content,
+				"post_id":   post.ID,
+				"parent_id": parent.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(parent.ID)).
+					Times(1).
+					Return(parent, nil)
+				params := db.CreateCommentParams{
+					Content:  comment.Content,
+					UserID:   int32(randomUser.ID),
+					PostID:   int32(post.ID),
+					ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+					Status:   db.CommentStatusApproved,
+				}
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(reply, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchComment(t, recorder.Body, reply)
+			},
+		},
+		{
+			name: "Parent Not Found",
+			body: gin.H{
+				"content":   comment.Content,
+				"post_id":   post.ID,
+				"parent_id": parent.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(parent.ID)).
+					Times(1).
+					Return(db.GetCommentRow{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Parent From Different Post",
+			body: gin.H{
+				"content":   comment.Content,
+				"post_id":   post.ID,
+				"parent_id": parent.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(parent.ID)).
+					Times(1).
+					Return(db.GetCommentRow{ID: parent.ID, PostID: parent.PostID + 1, Status: db.CommentStatusApproved}, nil)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Deleted Parent",
+			body: gin.H{
+				"content":   comment.Content,
+				"post_id":   post.ID,
+				"parent_id": parent.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(parent.ID)).
+					Times(1).
+					Return(db.GetCommentRow{ID: parent.ID, PostID: parent.PostID, IsDeleted: true, Status: db.CommentStatusApproved}, nil)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Post Not Found",
+			body: gin.H{
+				"content": comment.Content,
+				"post_id": post.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Draft Of Another User",
+			body: gin.H{
+				"content": comment.Content,
+				"post_id": post.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID) + 1, Status: db.PostStatusDraft}, nil)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Own Scheduled Post",
+			body: gin.H{
+				"content": comment.Content,
+				"post_id": post.ID,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID), Status: db.PostStatusScheduled}, nil)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(comment, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Post ID",
 			body: gin.H{
@@ -135,7 +372,7 @@ func TestCreateCommentAPI(t *testing.T) {
 				"post_id": 0,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -181,6 +418,9 @@ func TestCreateCommentAPI(t *testing.T) {
 
 func TestDeleteCommentAPI(t *testing.T) {
 	randomUser, _ := generateRandomUser(t)
+	moderator, _ := generateRandomUser(t)
+	moderator.ID = randomUser.ID + 1
+	moderator.Role = db.UserRoleModerator
 	comment := db.Comment{
 		ID:      int64(utils.RandomInt(1, 100)),
 		Content: "test comment",
@@ -199,7 +439,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "OK",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -214,19 +454,72 @@ func TestDeleteCommentAPI(t *testing.T) {
 					Times(1).
 					Return(randomUser, nil)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Eq(comment.ID)).
+					DeleteCommentTx(gomock.Any(), gomock.Eq(comment.ID)).
+					Times(1).
+					Return(db.DeleteCommentTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:      "OK Moderator",
+			commentID: comment.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, moderator.Email, utils.ModeratorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(comment.ID)).
+					Times(1).
+					Return(db.GetCommentRow{
+						ID:     comment.ID,
+						UserID: int32(randomUser.ID),
+					}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(moderator.Email)).
+					Times(1).
+					Return(moderator, nil)
+				store.EXPECT().
+					DeleteCommentTx(gomock.Any(), gomock.Eq(comment.ID)).
 					Times(1).
-					Return(nil)
+					Return(db.DeleteCommentTxResult{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNoContent, recorder.Code)
 			},
 		},
+		{
+			name:      "Already Deleted",
+			commentID: comment.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetComment(gomock.Any(), gomock.Eq(comment.ID)).
+					Times(1).
+					Return(db.GetCommentRow{
+						ID:        comment.ID,
+						UserID:    int32(randomUser.ID),
+						IsDeleted: true,
+					}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
 		{
 			name:      "Not Found",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -237,7 +530,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Any()).
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -248,7 +541,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "Unauthorized User",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -267,7 +560,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 						Email:    "unauthorized@example.com",
 					}, nil)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Any()).
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -278,7 +571,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "Invalid Comment ID",
 			commentID: 0,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -288,7 +581,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Any()).
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -299,7 +592,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "Internal Server Error GetComment",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -310,7 +603,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Any()).
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -321,7 +614,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "Internal Server Error GetUser",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -336,7 +629,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 					Times(1).
 					Return(db.User{}, sql.ErrConnDone)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Any()).
+					DeleteCommentTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -347,7 +640,7 @@ func TestDeleteCommentAPI(t *testing.T) {
 			name:      "Internal Server Error DeleteComment",
 			commentID: comment.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -362,9 +655,9 @@ func TestDeleteCommentAPI(t *testing.T) {
 					Times(1).
 					Return(randomUser, nil)
 				store.EXPECT().
-					DeleteComment(gomock.Any(), gomock.Eq(comment.ID)).
+					DeleteCommentTx(gomock.Any(), gomock.Eq(comment.ID)).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(db.DeleteCommentTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -421,7 +714,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -455,7 +748,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -480,7 +773,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -513,7 +806,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -537,7 +830,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -562,7 +855,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -591,7 +884,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "updated content",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -625,7 +918,7 @@ func TestUpdateCommentAPI(t *testing.T) {
 				"content": "",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -823,16 +1116,722 @@ func TestListCommentsAPI(t *testing.T) {
 	}
 }
 
+func TestListCommentTreeAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+
+	// two top-level comments, the first one has a reply that has its own reply
+	rows := []db.ListCommentTreeRow{
+		{ID: 1, Content: utils.RandomString(6), UserID: int32(randomUser.ID), Username: randomUser.Username, ReplyCount: 1},
+		{ID: 2, Content: utils.RandomString(6), UserID: int32(randomUser.ID), Username: randomUser.Username},
+		{
+			ID:         3,
+			Content:    "[deleted]",
+			UserID:     int32(randomUser.ID),
+			Username:   randomUser.Username,
+			ParentID:   sql.NullInt64{Int64: 1, Valid: true},
+			IsDeleted:  true,
+			Depth:      1,
+			ReplyCount: 1,
+		},
+		{
+			ID:       4,
+			Content:  utils.RandomString(6),
+			UserID:   int32(randomUser.ID),
+			Username: randomUser.Username,
+			ParentID: sql.NullInt64{Int64: 3, Valid: true},
+			Depth:    2,
+		},
+	}
+
+	type Query struct {
+		page     int
+		pageSize int
+	}
+
+	testCases := []struct {
+		name          string
+		postID        int64
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			postID: post.ID,
+			query: Query{
+				page:     1,
+				pageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListCommentTreeParams{
+					PostID: int32(post.ID),
+					Limit:  5,
+					Offset: 0,
+				}
+				store.EXPECT().
+					ListCommentTree(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(rows, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var tree []*commentTreeNode
+				err := json.Unmarshal(recorder.Body.Bytes(), &tree)
+				require.NoError(t, err)
+
+				require.Len(t, tree, 2)
+				require.Equal(t, int64(1), tree[0].ID)
+				require.Equal(t, int64(1), tree[0].ReplyCount)
+				require.Len(t, tree[0].Replies, 1)
+				require.Empty(t, tree[1].Replies)
+
+				deleted := tree[0].Replies[0]
+				require.Equal(t, int64(3), deleted.ID)
+				require.True(t, deleted.IsDeleted)
+				require.Equal(t, "[deleted]", deleted.Content)
+				require.Len(t, deleted.Replies, 1)
+				require.Equal(t, int64(4), deleted.Replies[0].ID)
+				require.Equal(t, int64(3), *deleted.Replies[0].ParentID)
+			},
+		},
+		{
+			name:   "Invalid Post ID",
+			postID: 0,
+			query: Query{
+				page:     1,
+				pageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentTree(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Invalid Page Size",
+			postID: post.ID,
+			query: Query{
+				page:     1,
+				pageSize: 99,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentTree(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			postID: post.ID,
+			query: Query{
+				page:     1,
+				pageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentTree(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCommentTreeRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/comments/%d/tree", tc.postID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListCommentRepliesAPI(t *testing.T) {
+	n := 5
+	randomUser, _ := generateRandomUser(t)
+	parentID := int64(utils.RandomInt(1, 100))
+	replies := make([]db.ListCommentRepliesRow, n)
+
+	for i := 0; i < n; i++ {
+		replies[i] = db.ListCommentRepliesRow{
+			ID:         parentID + int64(i) + 1,
+			Content:    utils.RandomString(6),
+			UserID:     int32(randomUser.ID),
+			Username:   randomUser.Username,
+			ParentID:   sql.NullInt64{Int64: parentID, Valid: true},
+			ReplyCount: int64(i),
+		}
+	}
+
+	type Query struct {
+		page     int
+		pageSize int
+	}
+
+	testCases := []struct {
+		name          string
+		commentID     int64
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			commentID: parentID,
+			query: Query{
+				page:     1,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListCommentRepliesParams{
+					ParentID: sql.NullInt64{Int64: parentID, Valid: true},
+					Limit:    int32(n),
+					Offset:   0,
+				}
+				store.EXPECT().
+					ListCommentReplies(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(replies, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotReplies []*commentTreeNode
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotReplies)
+				require.NoError(t, err)
+				require.Len(t, gotReplies, n)
+				for i, reply := range gotReplies {
+					require.Equal(t, replies[i].ID, reply.ID)
+					require.Equal(t, parentID, *reply.ParentID)
+					require.Equal(t, replies[i].ReplyCount, reply.ReplyCount)
+				}
+			},
+		},
+		{
+			name:      "Invalid Comment ID",
+			commentID: 0,
+			query: Query{
+				page:     1,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentReplies(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "Invalid Page",
+			commentID: parentID,
+			query: Query{
+				page:     0,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentReplies(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "Internal Server Error",
+			commentID: parentID,
+			query: Query{
+				page:     1,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCommentReplies(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCommentRepliesRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/comments/replies/%d", tc.commentID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateCommentModerationAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+	publishedPost := db.GetMinimalPostDataRow{ID: post.ID, AuthorID: post.AuthorID, Status: db.PostStatusPublished}
+
+	testCases := []struct {
+		name          string
+		content       string
+		rules         utils.CommentRules
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "First Comment Pending",
+			content: "first comment",
+			rules:   utils.CommentRules{HoldFirstComment: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetCommenterStats(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetCommenterStatsRow{}, nil)
+
+				params := db.CreateCommentParams{
+					Content:          "first comment",
+					UserID:           int32(randomUser.ID),
+					PostID:           int32(post.ID),
+					Status:           db.CommentStatusPending,
+					ModerationReason: "first comment of the user",
+				}
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.Comment{Content: params.Content, Status: params.Status}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var gotComment commentResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotComment)
+				require.NoError(t, err)
+				require.Equal(t, utils.CommentStatusPending, gotComment.Status)
+			},
+		},
+		{
+			name:    "Spam",
+			content: "visit http://a.com and http://b.com",
+			rules:   utils.CommentRules{MaxLinks: 1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetCommenterStats(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, params db.CreateCommentParams) (db.Comment, error) {
+						require.Equal(t, db.CommentStatusSpam, params.Status)
+						require.NotEmpty(t, params.ModerationReason)
+						return db.Comment{Content: params.Content, Status: params.Status}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:    "Internal Server Error GetCommenterStats",
+			content: "comment",
+			rules:   utils.CommentRules{RateLimit: 3, RateWindow: time.Minute},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
+					Times(1).
+					Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(publishedPost, nil)
+				store.EXPECT().
+					GetCommenterStats(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetCommenterStatsRow{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.CommentMaxLinks = tc.rules.MaxLinks
+			server.config.CommentHoldFirst = tc.rules.HoldFirstComment
+			server.config.CommentRateLimit = tc.rules.RateLimit
+			server.config.CommentRateWindow = tc.rules.RateWindow
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{
+				"content": tc.content,
+				"post_id": post.ID,
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/comments", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, randomUser.Email, utils.ReaderRole, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListModerationQueueAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	comments := []db.ListModerationQueueRow{
+		{
+			ID:               1,
+			Content:          utils.RandomString(6),
+			UserID:           int32(randomUser.ID),
+			Username:         randomUser.Username,
+			PostID:           1,
+			Status:           db.CommentStatusPending,
+			ModerationReason: "first comment of the user",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "page=1&page_size=5",
+			role:  utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListModerationQueueParams{
+					Status: db.CommentStatusPending,
+					Limit:  5,
+					Offset: 0,
+				}
+				store.EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(comments, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotComments []moderationQueueItem
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotComments)
+				require.NoError(t, err)
+				require.Len(t, gotComments, 1)
+				require.Equal(t, comments[0].ModerationReason, gotComments[0].ModerationReason)
+			},
+		},
+		{
+			name:  "OK Spam",
+			query: "status=spam&page=2&page_size=5",
+			role:  utils.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListModerationQueueParams{
+					Status: db.CommentStatusSpam,
+					Limit:  5,
+					Offset: 5,
+				}
+				store.EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return([]db.ListModerationQueueRow{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Forbidden",
+			query: "page=1&page_size=5",
+			role:  utils.AuthorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Status",
+			query: "status=approved&page=1&page_size=5",
+			role:  utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: "page=1&page_size=5",
+			role:  utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListModerationQueueRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := "/comments/moderation?" + tc.query
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, randomUser.Email, tc.role, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestModerateCommentsAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	ids := []int64{1, 2, 3}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"ids":    ids,
+				"status": utils.CommentStatusApproved,
+			},
+			role: utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.UpdateCommentsStatusParams{
+					Status: db.CommentStatusApproved,
+					Ids:    ids,
+				}
+				store.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(ids[:2], nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res moderateCommentsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, ids[:2], res.UpdatedIDs)
+			},
+		},
+		{
+			name: "Forbidden",
+			body: gin.H{
+				"ids":    ids,
+				"status": utils.CommentStatusApproved,
+			},
+			role: utils.AuthorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Status",
+			body: gin.H{
+				"ids":    ids,
+				"status": utils.CommentStatusPending,
+			},
+			role: utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Empty IDs",
+			body: gin.H{
+				"ids":    []int64{},
+				"status": utils.CommentStatusRejected,
+			},
+			role: utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"ids":    ids,
+				"status": utils.CommentStatusRejected,
+			},
+			role: utils.ModeratorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/comments/moderation", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, randomUser.Email, tc.role, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
 func requireBodyMatchComment(t *testing.T, body *bytes.Buffer, comment db.Comment) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
-	var gotComment db.Comment
+	var gotComment commentResponse
 	err = json.Unmarshal(data, &gotComment)
 	require.NoError(t, err)
 
 	require.Equal(t, comment.Content, gotComment.Content)
 	require.Equal(t, comment.UserID, gotComment.UserID)
 	require.Equal(t, comment.PostID, gotComment.PostID)
+	require.Equal(t, nullInt64ToPointer(comment.ParentID), gotComment.ParentID)
 	require.WithinDuration(t, comment.CreatedAt, gotComment.CreatedAt, time.Second)
 }
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"errors"
+	"github.com/aalug/blog-go/token"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// getJWKS returns the public keys used to verify the tokens as a JSON Web Key Set.
+// Tokens signed with a symmetric key cannot be verified by others, so there is no key set for them.
+func (server *Server) getJWKS(ctx *gin.Context) {
+	publisher, ok := server.tokenMaker.(token.KeySetPublisher)
+	if !ok {
+		err := errors.New("the token maker does not publish public keys")
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, publisher.JWKS())
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetJWKSAPI(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		config        utils.Config
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "JWT",
+			config: utils.Config{
+				TokenMaker:           token.JWTMakerType,
+				TokenJWTKeys:         []string{"key1:" + base64.RawURLEncoding.EncodeToString(privateKey.Seed())},
+				TokenJWTSigningKeyID: "key1",
+				AccessTokenDuration:  time.Minute,
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var jwks token.JWKS
+				err := json.Unmarshal(recorder.Body.Bytes(), &jwks)
+				require.NoError(t, err)
+				require.Len(t, jwks.Keys, 1)
+				require.Equal(t, "key1", jwks.Keys[0].Kid)
+				require.Equal(t, base64.RawURLEncoding.EncodeToString(privateKey.Public().(ed25519.PublicKey)), jwks.Keys[0].X)
+			},
+		},
+		{
+			name: "PASETO",
+			config: utils.Config{
+				TokenSymmetricKey:   utils.RandomString(32),
+				AccessTokenDuration: time.Minute,
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server, err := NewServer(tc.config, nil, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"fmt"
+	"github.com/aalug/blog-go/lockout"
+	"github.com/aalug/blog-go/worker"
+	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// isLoginLocked checks if the account or the client IP is locked after too many failed
+// login attempts. If it is, it responds with 429 and the Retry-After header.
+// The client IP is resolved by gin with the trusted proxies, so it cannot be spoofed.
+func (server *Server) isLoginLocked(ctx *gin.Context, email string) bool {
+	retryAfter, err := server.loginLimiter.Check(ctx, email, ctx.ClientIP())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return true
+	}
+
+	if retryAfter > 0 {
+		err := fmt.Errorf("too many failed login attempts, try again in %s", retryAfter.Round(time.Second))
+		ctx.Header("Retry-After", strconv.Itoa(lockout.RetryAfterSeconds(retryAfter)))
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(err))
+		return true
+	}
+
+	return false
+}
+
+// failLogin records a failed login attempt and, if it has locked the account,
+// sends an email about it to the owner of the account
+func (server *Server) failLogin(ctx *gin.Context, email string) error {
+	clientIP := ctx.ClientIP()
+	failure, err := server.loginLimiter.Fail(ctx, email, clientIP)
+	if err != nil {
+		return err
+	}
+
+	if !failure.AccountLocked {
+		return nil
+	}
+
+	taskPayload := &worker.PayloadSendLoginLockoutEmail{
+		Email:       email,
+		ClientIP:    clientIP,
+		LockedUntil: failure.LockedUntil,
+		RequestID:   ctx.GetString(requestIDKey),
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	return server.taskDistributor.DistributeTaskSendLoginLockoutEmail(ctx, taskPayload, opts...)
+}
@@ -0,0 +1,477 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	mockdb "github.com/aalug/blog-go/db/mock"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/lockout"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/worker"
+	mockwk "github.com/aalug/blog-go/worker/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLoginUserLockoutAPI(t *testing.T) {
+	user, password := generateRandomUser(t)
+
+	config := utils.Config{
+		LoginMaxAttempts:        3,
+		LoginMaxAttemptsPerIP:   10,
+		LoginAttemptWindow:      time.Hour,
+		LoginLockoutDuration:    time.Minute,
+		LoginMaxLockoutDuration: time.Hour,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		forwardedFor  string
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK Resets Attempts",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					DeleteLoginAttempt(gomock.Any(), gomock.Eq("account:"+user.Email)).
+					Times(1)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.TwoFactorSecret{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Locked",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{
+						{
+							Key:            "account:" + user.Email,
+							FailedAttempts: 3,
+							LockedUntil:    sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+						},
+					}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+
+				retryAfter, err := strconv.Atoi(recorder.Header().Get("Retry-After"))
+				require.NoError(t, err)
+				require.InDelta(t, 60, retryAfter, 1)
+			},
+		},
+		{
+			name: "Wrong Password Locks Account",
+			body: gin.H{
+				"email":    user.Email,
+				"password": "wrong-password",
+			},
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					RecordFailedLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(2).
+					DoAndReturn(func(_ context.Context, arg db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error) {
+						// the 3rd failed attempt to the account, the 1st one from the IP
+						attempts := int32(1)
+						if arg.Key == "account:"+user.Email {
+							attempts = 3
+						}
+						return db.LoginAttempt{Key: arg.Key, FailedAttempts: attempts}, nil
+					})
+				store.EXPECT().
+					LockLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.LockLoginAttemptParams) error {
+						require.Equal(t, "account:"+user.Email, arg.Key)
+						require.WithinDuration(t, time.Now().Add(time.Minute), arg.LockedUntil.Time, time.Second)
+						return nil
+					})
+				distributor.EXPECT().
+					DistributeTaskSendLoginLockoutEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, payload *worker.PayloadSendLoginLockoutEmail, _ ...interface{}) error {
+						require.Equal(t, user.Email, payload.Email)
+						require.Equal(t, "192.0.2.1", payload.ClientIP)
+						require.Equal(t, "lockout-request", payload.RequestID)
+						require.False(t, payload.LockedUntil.IsZero())
+						return nil
+					})
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Unknown Email Limited By IP",
+			body: gin.H{
+				"email":    utils.RandomEmail(),
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					RecordFailedLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error) {
+						require.Equal(t, "ip:192.0.2.1", arg.Key)
+						return db.LoginAttempt{Key: arg.Key, FailedAttempts: 1}, nil
+					})
+				distributor.EXPECT().
+					DistributeTaskSendLoginLockoutEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Spoofed Header Limited By Peer IP",
+			body: gin.H{
+				"email":    utils.RandomEmail(),
+				"password": password,
+			},
+			// the client is not a trusted proxy, so its x-forwarded-for is ignored
+			forwardedFor: "198.51.100.1",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, keys []string) ([]db.LoginAttempt, error) {
+						require.Contains(t, keys, "ip:192.0.2.1")
+						return []db.LoginAttempt{
+							{
+								Key:            "ip:192.0.2.1",
+								FailedAttempts: 10,
+								LockedUntil:    sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+							},
+						}, nil
+					})
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			distributor := mockwk.NewMockTaskDistributor(ctrl)
+			tc.buildStubs(store, distributor)
+
+			server := newTestServer(t, store)
+			server.loginLimiter = lockout.NewLimiter(store, config)
+			server.taskDistributor = distributor
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/login", bytes.NewReader(data))
+			require.NoError(t, err)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("X-Request-ID", "lockout-request")
+			if tc.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tc.forwardedFor)
+			}
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestLoginTwoFactorLockoutAPI(t *testing.T) {
+	user, password := generateRandomUser(t)
+
+	secret, _, err := utils.GenerateTOTPKey("blog-go", user.Email)
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := totp.GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	twoFactorSecret := db.TwoFactorSecret{
+		Email:     user.Email,
+		Secret:    secret,
+		IsEnabled: true,
+	}
+	challenge := db.TwoFactorChallenge{
+		ID:        uuid.New(),
+		Email:     user.Email,
+		ExpiredAt: time.Now().Add(5 * time.Minute),
+	}
+	lockedAccount := []db.LoginAttempt{
+		{
+			Key:            "account:" + user.Email,
+			FailedAttempts: 3,
+			LockedUntil:    sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+		},
+	}
+
+	config := utils.Config{
+		LoginMaxAttempts:        3,
+		LoginMaxAttemptsPerIP:   10,
+		LoginAttemptWindow:      time.Hour,
+		LoginLockoutDuration:    time.Minute,
+		LoginMaxLockoutDuration: time.Hour,
+	}
+
+	testCases := []struct {
+		name          string
+		path          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Password Does Not Reset Attempts",
+			path: "/users/login",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// checked before the password and before the challenge is issued
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(2).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					CreateTwoFactorChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					DeleteLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code Recorded",
+			path: "/users/login/two_factor",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             invalidTOTPCode(code),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				// the code is recorded for the account and for the client IP
+				store.EXPECT().
+					RecordFailedLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(2).
+					DoAndReturn(func(_ context.Context, arg db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error) {
+						require.Contains(t, []string{"account:" + user.Email, "ip:192.0.2.1"}, arg.Key)
+						return db.LoginAttempt{Key: arg.Key, FailedAttempts: 1}, nil
+					})
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Locked Account",
+			path: "/users/login/two_factor",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(lockedAccount, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "OK Resets Attempts",
+			path: "/users/login/two_factor",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetLoginAttempts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.LoginAttempt{}, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					DeleteLoginAttempt(gomock.Any(), gomock.Eq("account:"+user.Email)).
+					Times(1)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.loginLimiter = lockout.NewLimiter(store, config)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, tc.path, bytes.NewReader(data))
+			require.NoError(t, err)
+			req.RemoteAddr = "192.0.2.1:1234"
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
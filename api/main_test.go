@@ -1,9 +1,11 @@
 package api
 
 import (
+	mockdb "github.com/aalug/blog-go/db/mock"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"os"
 	"testing"
@@ -14,9 +16,23 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	config := utils.Config{
 		TokenSymmetricKey:   utils.RandomString(32),
 		AccessTokenDuration: time.Minute,
+		TwoFactorIssuer:     "blog-go",
 	}
 
-	server, err := NewServer(config, store)
+	// passwords of the test users have never been changed and they are admins,
+	// so none of the tokens is rejected or lowered by the authentication
+	if mockStore, ok := store.(*mockdb.MockStore); ok {
+		mockStore.EXPECT().
+			GetUserPasswordChangedAt(gomock.Any(), gomock.Any()).
+			AnyTimes().
+			Return(time.Time{}, nil)
+		mockStore.EXPECT().
+			GetUserRole(gomock.Any(), gomock.Any()).
+			AnyTimes().
+			Return(utils.AdminRole, nil)
+	}
+
+	server, err := NewServer(config, store, nil)
 	require.NoError(t, err)
 
 	return server
@@ -3,20 +3,30 @@ package api
 import (
 	"errors"
 	"fmt"
+	"github.com/aalug/blog-go/metrics"
+	"github.com/aalug/blog-go/ratelimit"
 	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	authorizationHeaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
 	authorizationPayloadKey = "authorization_payload"
+	requestIDHeaderKey      = "X-Request-ID"
+	requestIDKey            = "request_id"
 )
 
-// AuthMiddleware creates a gin middleware for authorization
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// AuthMiddleware creates a gin middleware for authorization.
+// It rejects tokens issued before the last password change of the user and tokens whose role,
+// lowered to the current role of a demoted user, is lower than requiredRole.
+func authMiddleware(tokenMaker token.Maker, passwordChanges *token.PasswordChangeCache, requiredRole string) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 
@@ -47,7 +57,96 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		err = passwordChanges.Check(ctx, payload)
+		if err != nil {
+			if errors.Is(err, token.ErrRevokedToken) || errors.Is(err, token.ErrInvalidToken) {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if !utils.HasRole(payload.Role, requiredRole) {
+			err := fmt.Errorf("role %s is not allowed to perform this action", payload.Role)
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
+
+// optionalAuthMiddleware creates a gin middleware that sets the authorization
+// payload when a valid bearer token is provided, but does not reject the request otherwise
+func optionalAuthMiddleware(tokenMaker token.Maker, passwordChanges *token.PasswordChangeCache) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		fields := strings.Fields(ctx.GetHeader(authorizationHeaderKey))
+		if len(fields) >= 2 && strings.ToLower(fields[0]) == authorizationTypeBearer {
+			payload, err := tokenMaker.VerifyToken(fields[1])
+			if err == nil && passwordChanges.Check(ctx, payload) == nil {
+				ctx.Set(authorizationPayloadKey, payload)
+			}
+		}
+		ctx.Next()
+	}
+}
+
+// rateLimitMiddleware creates a gin middleware that limits requests per authenticated user
+// or, for anonymous requests, per client IP. Routes are named "<METHOD>:<path>",
+// for example "POST:/users/login".
+func rateLimitMiddleware(limiter *ratelimit.Limiter, tokenMaker token.Maker) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		subject := ratelimit.IPSubject(ctx.ClientIP())
+		fields := strings.Fields(ctx.GetHeader(authorizationHeaderKey))
+		if len(fields) >= 2 && strings.ToLower(fields[0]) == authorizationTypeBearer {
+			if payload, err := tokenMaker.VerifyToken(fields[1]); err == nil {
+				subject = ratelimit.UserSubject(payload.Email)
+			}
+		}
+
+		result, err := limiter.Allow(ctx, ctx.Request.Method+":"+ctx.FullPath(), subject)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if !result.Allowed {
+			ctx.Header("Retry-After", strconv.Itoa(result.RetryAfterSeconds()))
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, errorResponse(errors.New("rate limit exceeded")))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// requestIDMiddleware creates a gin middleware that sets the ID of the request - the valid X-Request-ID
+// header of the client or a new one - in the context and in the X-Request-ID header of the response
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeaderKey)
+		if !utils.IsValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		ctx.Set(requestIDKey, requestID)
+		ctx.Header(requestIDHeaderKey, requestID)
+		ctx.Next()
+	}
+}
+
+// metricsMiddleware creates a gin middleware that records the number and the latency
+// of requests per route. Requests that do not match any route are recorded with an empty route.
+func metricsMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		startTime := time.Now()
+		ctx.Next()
+		duration := time.Since(startTime)
+
+		route := ctx.FullPath()
+		metrics.HTTPRequestsTotal.WithLabelValues(ctx.Request.Method, route, strconv.Itoa(ctx.Writer.Status())).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(ctx.Request.Method, route).Observe(duration.Seconds())
+	}
+}
@@ -1,9 +1,16 @@
 package api
 
 import (
+	"database/sql"
 	"fmt"
+	mockdb "github.com/aalug/blog-go/db/mock"
+	"github.com/aalug/blog-go/metrics"
+	"github.com/aalug/blog-go/ratelimit"
 	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
@@ -17,9 +24,10 @@ func addAuthorization(
 	tokenMaker token.Maker,
 	authorizationType string,
 	email string,
+	role string,
 	duration time.Duration,
 ) {
-	tkn, payload, err := tokenMaker.CreateToken(email, duration)
+	tkn, payload, err := tokenMaker.CreateToken(email, role, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -36,7 +44,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", utils.AuthorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -52,7 +60,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Unsupported authorization type",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, "unsupported auth type", "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, "unsupported auth type", "user@example.com", utils.AuthorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -61,7 +69,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Invalid authorization format",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, "", "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, "", "user@example.com", utils.AuthorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -70,21 +78,42 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Expired token",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", -time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", utils.AuthorRole, -time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name: "Insufficient role",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", utils.ReaderRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Higher role",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", utils.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			server := newTestServer(t, nil) // nil because for middleware tests db is not needed
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mockdb.NewMockStore(ctrl))
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.passwordChanges, utils.AuthorRole),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -100,3 +129,257 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewarePasswordChange(t *testing.T) {
+	email := "user@example.com"
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Token Issued After Password Change",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserPasswordChangedAt(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(time.Now().Add(-time.Hour), nil)
+				store.EXPECT().
+					GetUserRole(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(utils.ReaderRole, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Token Issued Before Password Change",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserPasswordChangedAt(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(time.Now().Add(time.Second), nil)
+				store.EXPECT().
+					GetUserRole(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(utils.ReaderRole, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "User Not Found",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserPasswordChangedAt(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(time.Time{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserPasswordChangedAt(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(time.Time{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// the server is created with a nil store, so newTestServer
+			// does not stub the password change lookups
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			server := newTestServer(t, nil)
+			passwordChanges := token.NewPasswordChangeCache(store, time.Minute)
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				authMiddleware(server.tokenMaker, passwordChanges, utils.ReaderRole),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, email, utils.ReaderRole, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAuthMiddlewareRoleChange(t *testing.T) {
+	email := "user@example.com"
+
+	testCases := []struct {
+		name          string
+		currentRole   string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "Role Unchanged",
+			currentRole: utils.ModeratorRole,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:        "Demoted User",
+			currentRole: utils.AuthorRole,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetUserPasswordChangedAt(gomock.Any(), gomock.Eq(email)).
+				Times(1).
+				Return(time.Now().Add(-time.Hour), nil)
+			store.EXPECT().
+				GetUserRole(gomock.Any(), gomock.Eq(email)).
+				Times(1).
+				Return(tc.currentRole, nil)
+			server := newTestServer(t, nil)
+			passwordChanges := token.NewPasswordChangeCache(store, time.Minute)
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				authMiddleware(server.tokenMaker, passwordChanges, utils.ModeratorRole),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			// the token was issued before the user was demoted
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, email, utils.ModeratorRole, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	limits, err := ratelimit.ParseLimits("", []string{"GET:/limited=2/1m"})
+	require.NoError(t, err)
+
+	server := newTestServer(t, nil)
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), limits)
+
+	limitedPath := "/limited"
+	server.router.GET(
+		limitedPath,
+		rateLimitMiddleware(limiter, server.tokenMaker),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	sendRequestWithHeader := func(remoteAddr, forwardedFor, email string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, limitedPath, nil)
+		require.NoError(t, err)
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		if email != "" {
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, email, utils.AuthorRole, time.Minute)
+		}
+
+		server.router.ServeHTTP(recorder, req)
+		return recorder
+	}
+	sendRequest := func(remoteAddr, email string) *httptest.ResponseRecorder {
+		return sendRequestWithHeader(remoteAddr, "", email)
+	}
+
+	// anonymous requests are limited per client IP
+	for i := 0; i < 2; i++ {
+		require.Equal(t, http.StatusOK, sendRequest("192.0.2.1:1234", "").Code)
+	}
+	recorder := sendRequest("192.0.2.1:5678", "")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "30", recorder.Header().Get("Retry-After"))
+
+	require.Equal(t, http.StatusOK, sendRequest("192.0.2.2:1234", "").Code)
+
+	// authenticated requests are limited per user, from any IP
+	email := utils.RandomEmail()
+	require.Equal(t, http.StatusOK, sendRequest("192.0.2.1:1234", email).Code)
+	require.Equal(t, http.StatusOK, sendRequest("192.0.2.3:1234", email).Code)
+	require.Equal(t, http.StatusTooManyRequests, sendRequest("192.0.2.4:1234", email).Code)
+
+	// x-forwarded-for is ignored if the request does not come from a trusted proxy
+	require.Equal(t, http.StatusOK, sendRequestWithHeader("192.0.2.5:1234", "198.51.100.1", "").Code)
+	require.Equal(t, http.StatusOK, sendRequestWithHeader("192.0.2.5:1234", "198.51.100.2", "").Code)
+	require.Equal(t, http.StatusTooManyRequests, sendRequestWithHeader("192.0.2.5:1234", "198.51.100.3", "").Code)
+
+	// behind a trusted proxy, the hops sent by the client are ignored
+	require.Equal(t, http.StatusOK, sendRequestWithHeader("127.0.0.1:1234", "198.51.100.1, 192.0.2.6", "").Code)
+	require.Equal(t, http.StatusOK, sendRequestWithHeader("127.0.0.1:1234", "198.51.100.2, 192.0.2.6", "").Code)
+	require.Equal(t, http.StatusTooManyRequests, sendRequestWithHeader("127.0.0.1:1234", "198.51.100.3, 192.0.2.6", "").Code)
+	require.Equal(t, http.StatusOK, sendRequestWithHeader("127.0.0.1:1234", "192.0.2.7", "").Code)
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	server.router.GET("/metrics_test/:id", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusTeapot, gin.H{})
+	})
+
+	requestsTotal := metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "/metrics_test/:id", "418")
+	countBefore := testutil.ToFloat64(requestsTotal)
+
+	for _, path := range []string{"/metrics_test/1", "/metrics_test/2"} {
+		recorder := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+
+		server.router.ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusTeapot, recorder.Code)
+	}
+
+	// requests are recorded per route, not per path
+	require.Equal(t, countBefore+2, testutil.ToFloat64(requestsTotal))
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), `blog_http_requests_total{code="418",method="GET",route="/metrics_test/:id"}`)
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/sso"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type oidcLoginResponse struct {
+	AuthURL string `json:"auth_url"`
+	State   string `json:"state"`
+}
+
+// oidcLogin starts a login with the OIDC provider. The client redirects the user to the auth URL
+// and, after the user consents, sends the code and the state from the redirect to oidcCallback.
+func (server *Server) oidcLogin(ctx *gin.Context) {
+	if server.oidcProvider == nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(sso.ErrNotConfigured))
+		return
+	}
+
+	request, err := sso.NewAuthRequest()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.store.CreateOIDCState(ctx, db.CreateOIDCStateParams{
+		State:        request.State,
+		Nonce:        request.Nonce,
+		CodeVerifier: request.CodeVerifier,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := oidcLoginResponse{
+		AuthURL: server.oidcProvider.AuthCodeURL(request),
+		State:   request.State,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type oidcCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+// oidcCallback completes the login with the OIDC provider. The identity is linked
+// to the user with the same (verified) email or to a new user, then the login
+// continues as in loginUser, including the two-factor authentication.
+func (server *Server) oidcCallback(ctx *gin.Context) {
+	if server.oidcProvider == nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(sso.ErrNotConfigured))
+		return
+	}
+
+	var request oidcCallbackRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the state can be used only once
+	state, err := server.store.UseOIDCState(ctx, request.State)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("invalid or expired state")
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	identity, err := server.oidcProvider.Exchange(ctx, request.Code, sso.AuthRequest{
+		State:        state.State,
+		Nonce:        state.Nonce,
+		CodeVerifier: state.CodeVerifier,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		err := errors.New("the email of the identity is not verified")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	// users created from an identity can log in only with the provider,
+	// until they reset the password
+	password, err := utils.RandomToken()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.OIDCLoginTx(ctx, db.OIDCLoginTxParams{
+		Issuer:         identity.Issuer,
+		Subject:        identity.Subject,
+		Email:          identity.Email,
+		Username:       identity.Username(),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	server.completeLogin(ctx, result.User)
+}
@@ -0,0 +1,371 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	mockdb "github.com/aalug/blog-go/db/mock"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/sso"
+	"github.com/aalug/blog-go/sso/ssotest"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOIDCLoginAPI(t *testing.T) {
+	oidcServer := ssotest.NewServer(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateOIDCState(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateOIDCStateParams) (db.OIDCState, error) {
+						require.NotEmpty(t, arg.State)
+						require.NotEmpty(t, arg.Nonce)
+						require.NotEmpty(t, arg.CodeVerifier)
+
+						return db.OIDCState{State: arg.State, Nonce: arg.Nonce, CodeVerifier: arg.CodeVerifier}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res oidcLoginResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				authURL, err := url.Parse(res.AuthURL)
+				require.NoError(t, err)
+				require.Equal(t, oidcServer.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+				require.Equal(t, res.State, authURL.Query().Get("state"))
+				require.NotEmpty(t, authURL.Query().Get("nonce"))
+				require.NotEmpty(t, authURL.Query().Get("code_challenge"))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateOIDCState(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.OIDCState{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestOIDCServer(t, store, oidcServer)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/users/oidc/login", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestOIDCCallbackAPI(t *testing.T) {
+	oidcServer := ssotest.NewServer(t)
+	user, _ := generateRandomUser(t)
+	user.IsEmailVerified = true
+
+	verifiedClaims := ssotest.Claims{
+		Subject:       utils.RandomString(12),
+		Email:         user.Email,
+		EmailVerified: true,
+		Name:          utils.RandomString(8),
+	}
+
+	testCases := []struct {
+		name          string
+		claims        ssotest.Claims
+		body          func(code, state string) gin.H
+		buildStubs    func(store *mockdb.MockStore, request sso.AuthRequest)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"code": code, "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				expectUseOIDCState(store, request)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.OIDCLoginTxParams) (db.OIDCLoginTxResult, error) {
+						require.Equal(t, oidcServer.URL, arg.Issuer)
+						require.Equal(t, verifiedClaims.Subject, arg.Subject)
+						require.Equal(t, user.Email, arg.Email)
+						require.NotEmpty(t, arg.Username)
+						require.NotEmpty(t, arg.HashedPassword)
+
+						return db.OIDCLoginTxResult{User: user}, nil
+					})
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.TwoFactorSecret{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateSessionParams) (db.Session, error) {
+						return db.Session{ID: arg.ID, Email: arg.Email, ExpiresAt: arg.ExpiresAt}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res loginUserResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.NotEmpty(t, res.RefreshToken)
+				require.Equal(t, user.Email, res.User.Email)
+			},
+		},
+		{
+			name:   "Two-Factor Enabled",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"code": code, "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				expectUseOIDCState(store, request)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.OIDCLoginTxResult{User: user}, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.TwoFactorSecret{Email: user.Email, IsEnabled: true}, nil)
+				store.EXPECT().
+					CreateTwoFactorChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateTwoFactorChallengeParams) (db.TwoFactorChallenge, error) {
+						return db.TwoFactorChallenge{
+							ID:        arg.ID,
+							Email:     arg.Email,
+							ExpiredAt: time.Now().Add(5 * time.Minute),
+						}, nil
+					})
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+
+				var res twoFactorChallengeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.True(t, res.TwoFactorRequired)
+				require.NotEqual(t, uuid.Nil, res.TwoFactorToken)
+				require.NotContains(t, recorder.Body.String(), "access_token")
+			},
+		},
+		{
+			name: "Email Not Verified",
+			claims: ssotest.Claims{
+				Subject: utils.RandomString(12),
+				Email:   user.Email,
+			},
+			body: func(code, state string) gin.H {
+				return gin.H{"code": code, "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				expectUseOIDCState(store, request)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:   "Invalid Code",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"code": utils.RandomString(32), "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				expectUseOIDCState(store, request)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "Invalid State",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"code": code, "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				store.EXPECT().
+					UseOIDCState(gomock.Any(), gomock.Eq(request.State)).
+					Times(1).
+					Return(db.OIDCState{}, sql.ErrNoRows)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "Missing Code",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				store.EXPECT().
+					UseOIDCState(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			claims: verifiedClaims,
+			body: func(code, state string) gin.H {
+				return gin.H{"code": code, "state": state}
+			},
+			buildStubs: func(store *mockdb.MockStore, request sso.AuthRequest) {
+				expectUseOIDCState(store, request)
+				store.EXPECT().
+					OIDCLoginTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.OIDCLoginTxResult{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestOIDCServer(t, store, oidcServer)
+			recorder := httptest.NewRecorder()
+
+			request, err := sso.NewAuthRequest()
+			require.NoError(t, err)
+			code, state := oidcServer.Authorize(t, server.oidcProvider.AuthCodeURL(request), tc.claims)
+
+			tc.buildStubs(store, request)
+
+			data, err := json.Marshal(tc.body(code, state))
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/oidc/callback", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestOIDCNotConfiguredAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		CreateOIDCState(gomock.Any(), gomock.Any()).
+		Times(0)
+	store.EXPECT().
+		UseOIDCState(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store)
+	require.Nil(t, server.oidcProvider)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/users/oidc/login", nil)
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	data, err := json.Marshal(gin.H{"code": utils.RandomString(32), "state": utils.RandomString(32)})
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodPost, "/users/oidc/callback", bytes.NewReader(data))
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+// newTestOIDCServer creates a test server with the OIDC login using the stand-in provider
+func newTestOIDCServer(t *testing.T, store db.Store, oidcServer *ssotest.Server) *Server {
+	server := newTestServer(t, store)
+
+	provider, err := sso.NewProvider(context.Background(), oidcServer.Config(server.config))
+	require.NoError(t, err)
+	server.oidcProvider = provider
+
+	return server
+}
+
+// expectUseOIDCState expects the state of the login request to be used once
+func expectUseOIDCState(store *mockdb.MockStore, request sso.AuthRequest) {
+	store.EXPECT().
+		UseOIDCState(gomock.Any(), gomock.Eq(request.State)).
+		Times(1).
+		Return(db.OIDCState{
+			State:        request.State,
+			Nonce:        request.Nonce,
+			CodeVerifier: request.CodeVerifier,
+		}, nil)
+}
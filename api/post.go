@@ -6,6 +6,7 @@ import (
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/token"
 	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strings"
@@ -19,16 +20,21 @@ type createPostRequest struct {
 	Tags        []string `json:"tags" binding:"required"`
 	Category    string   `json:"category" binding:"required,alpha"`
 	Image       string   `json:"image" binding:"required"`
+	// Status defaults to published. Scheduled posts also need PublishAt.
+	Status    string     `json:"status" binding:"omitempty,oneof=draft scheduled published archived"`
+	PublishAt *time.Time `json:"publish_at"`
 }
 
 type createPostResponse struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Content     string   `json:"content"`
-	Author      string   `json:"author"`
-	Category    string   `json:"category"`
-	Tags        []string `json:"tags"`
-	Image       string   `json:"image"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Content     string     `json:"content"`
+	Author      string     `json:"author"`
+	Category    string     `json:"category"`
+	Tags        []string   `json:"tags"`
+	Image       string     `json:"image"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
 }
 
 // createPost creates a new post
@@ -39,6 +45,14 @@ func (server *Server) createPost(ctx *gin.Context) {
 		return
 	}
 
+	if request.Status == "" {
+		request.Status = string(db.PostStatusPublished)
+	}
+	if err := validation.ValidatePostSchedule(request.Status, timeValue(request.PublishAt)); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	// get or create category and get the id
 	categoryID, err := server.store.GetOrCreateCategory(ctx, request.Category)
 	if err != nil {
@@ -60,6 +74,8 @@ func (server *Server) createPost(ctx *gin.Context) {
 		AuthorID:    int32(authUser.ID),
 		CategoryID:  int32(categoryID),
 		Image:       request.Image,
+		Status:      db.PostStatus(request.Status),
+		PublishAt:   newNullTime(request.PublishAt),
 	}
 
 	post, err := server.store.CreatePost(ctx, params)
@@ -86,6 +102,8 @@ func (server *Server) createPost(ctx *gin.Context) {
 		Category:    request.Category,
 		Tags:        request.Tags,
 		Image:       post.Image,
+		Status:      string(post.Status),
+		PublishAt:   nullTimeToPointer(post.PublishAt),
 	}
 
 	ctx.JSON(http.StatusCreated, res)
@@ -124,7 +142,8 @@ func (server *Server) deletePost(ctx *gin.Context) {
 		return
 	}
 
-	if post.AuthorID != int32(authUser.ID) {
+	// moderators can delete posts of other users
+	if post.AuthorID != int32(authUser.ID) && !utils.HasRole(string(authUser.Role), utils.ModeratorRole) {
 		err := errors.New("post does not belong to the authenticated user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
@@ -144,16 +163,19 @@ type getPostByIDRequest struct {
 }
 
 type getPostResponse struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Content     string   `json:"content"`
-	Author      string   `json:"author"`
-	Category    string   `json:"category"`
-	Tags        []string `json:"tags"`
-	Image       string   `json:"image"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Content     string     `json:"content"`
+	Author      string     `json:"author"`
+	Category    string     `json:"category"`
+	Tags        []string   `json:"tags"`
+	Image       string     `json:"image"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
 }
 
-// getPostByID gets post details by id
+// getPostByID gets post details by id. Posts that are not published
+// are visible only to their authors.
 func (server *Server) getPostByID(ctx *gin.Context) {
 	var request getPostByIDRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
@@ -172,6 +194,12 @@ func (server *Server) getPostByID(ctx *gin.Context) {
 		return
 	}
 
+	if !isPostVisible(ctx, post.Status, post.AuthorEmail) {
+		err := errors.New("post not found")
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
 	// get tags for this post
 	tags, err := server.store.GetTagsOfPost(ctx, post.ID)
 	if err != nil {
@@ -191,6 +219,8 @@ func (server *Server) getPostByID(ctx *gin.Context) {
 		Category:    post.CategoryName,
 		Tags:        tagNames,
 		Image:       post.Image,
+		Status:      string(post.Status),
+		PublishAt:   nullTimeToPointer(post.PublishAt),
 	}
 
 	ctx.JSON(http.StatusOK, res)
@@ -200,7 +230,8 @@ type getPostByTitleRequest struct {
 	Slug string `uri:"slug" binding:"required,slug"`
 }
 
-// getPostByID gets post details by title
+// getPostByTitle gets post details by title. Posts that are not published
+// are visible only to their authors.
 func (server *Server) getPostByTitle(ctx *gin.Context) {
 	var request getPostByTitleRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
@@ -221,6 +252,12 @@ func (server *Server) getPostByTitle(ctx *gin.Context) {
 		return
 	}
 
+	if !isPostVisible(ctx, post.Status, post.AuthorEmail) {
+		err := errors.New("post not found")
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
 	// get tags for this post
 	tags, err := server.store.GetTagsOfPost(ctx, post.ID)
 	if err != nil {
@@ -240,6 +277,8 @@ func (server *Server) getPostByTitle(ctx *gin.Context) {
 		Category:    post.CategoryName,
 		Tags:        tagNames,
 		Image:       post.Image,
+		Status:      string(post.Status),
+		PublishAt:   nullTimeToPointer(post.PublishAt),
 	}
 
 	ctx.JSON(http.StatusOK, res)
@@ -418,15 +457,21 @@ type updatePostRequest struct {
 	Tags        []string `json:"tags"`
 	Category    string   `json:"category"`
 	Image       string   `json:"image"`
+	// PublishAt can be set only together with the scheduled status.
+	Status    string     `json:"status" binding:"omitempty,oneof=draft scheduled published archived"`
+	PublishAt *time.Time `json:"publish_at"`
 }
 
 type updatePostResponse struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Content     string   `json:"content"`
-	Category    string   `json:"category"`
-	Tags        []string `json:"tags"`
-	Image       string   `json:"image"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Content     string     `json:"content"`
+	Category    string     `json:"category"`
+	Tags        []string   `json:"tags"`
+	Image       string     `json:"image"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	Revision    int32      `json:"revision"`
 }
 
 // updatePost updates a post with provided details
@@ -471,12 +516,21 @@ func (server *Server) updatePost(ctx *gin.Context) {
 		request.Content == "" &&
 		len(request.Tags) == 0 &&
 		request.Category == "" &&
-		request.Image == "" {
+		request.Image == "" &&
+		request.Status == "" &&
+		request.PublishAt == nil {
 		err := errors.New("no fields to update")
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
+	if request.Status != "" || request.PublishAt != nil {
+		if err := validation.ValidatePostSchedule(request.Status, timeValue(request.PublishAt)); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
 	post, err := server.store.GetPostByID(ctx, uriRequest.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -525,54 +579,29 @@ func (server *Server) updatePost(ctx *gin.Context) {
 		UpdatedAt: time.Now(),
 	}
 
-	updatedPost, err := server.store.UpdatePost(ctx, params)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+	txParams := db.UpdatePostTxParams{
+		UpdatePostParams: params,
+		EditorID:         int32(authUser.ID),
+	}
+	if request.Status != "" {
+		txParams.Status = db.NullPostStatus{PostStatus: db.PostStatus(request.Status), Valid: true}
+		txParams.PublishAt = newNullTime(request.PublishAt)
 	}
-
 	if len(request.Tags) > 0 {
-		postTags, err := server.store.GetTagsOfPost(ctx, uriRequest.ID)
-		postTagNames := make([]string, len(postTags))
-		for i, postTag := range postTags {
-			postTagNames[i] = postTag.Name
-		}
-
-		// s1 - tags that are in post but not in request - should be removed
-		// s2 - tags that are in request but not in post - should be added
-		s1, s2 := utils.CompareTagLists(postTagNames, request.Tags)
-
-		if len(s2) > 0 {
-			paramsToAdd := db.AddTagsToPostParams{
-				PostID: uriRequest.ID,
-				Tags:   s2,
-			}
-			err = server.store.AddTagsToPost(ctx, paramsToAdd)
-			if err != nil {
-				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-				return
-			}
-		}
-		if len(s1) > 0 {
-			paramsToRemove := db.RemoveTagsFromPostParams{
-				PostID: uriRequest.ID,
-				Tags:   s1,
-			}
-			err = server.store.RemoveTagsFromPost(ctx, paramsToRemove)
-			if err != nil {
-				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-				return
-			}
-		}
+		txParams.Tags = request.Tags
 	}
 
-	tags, err := server.store.GetTagsOfPost(ctx, uriRequest.ID)
+	// every update is recorded as a new revision of the post,
+	// the status and the tags are changed in the same transaction
+	result, err := server.store.UpdatePostTx(ctx, txParams)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	tagsAfterUpdate := make([]string, len(tags))
-	for i, tag := range tags {
+	updatedPost := result.Post
+
+	tagsAfterUpdate := make([]string, len(result.Tags))
+	for i, tag := range result.Tags {
 		tagsAfterUpdate[i] = tag.Name
 	}
 
@@ -583,7 +612,163 @@ func (server *Server) updatePost(ctx *gin.Context) {
 		Category:    categoryName,
 		Tags:        tagsAfterUpdate,
 		Image:       updatedPost.Image,
+		Status:      string(updatedPost.Status),
+		PublishAt:   nullTimeToPointer(updatedPost.PublishAt),
+		Revision:    result.Revision.Revision,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type searchPostsRequest struct {
+	Query    string `form:"query" binding:"required,max=250"`
+	Page     int32  `form:"page" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+type searchPostsResponse struct {
+	Total int64               `json:"total"`
+	Posts []db.SearchPostsRow `json:"posts"`
+}
+
+// searchPosts runs a full-text search over posts titles, descriptions
+// and contents. Results are ordered by rank and contain highlighted snippets,
+// which are HTML-escaped with the matches wrapped in <mark> tags.
+func (server *Server) searchPosts(ctx *gin.Context) {
+	var request searchPostsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	total, err := server.store.CountSearchPosts(ctx, request.Query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	params := db.SearchPostsParams{
+		Query:      request.Query,
+		PageLimit:  request.PageSize,
+		PageOffset: (request.Page - 1) * request.PageSize,
+	}
+
+	posts, err := server.store.SearchPosts(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := searchPostsResponse{
+		Total: total,
+		Posts: posts,
 	}
 
 	ctx.JSON(http.StatusOK, res)
 }
+
+type listOwnPostsRequest struct {
+	Page     int32  `form:"page" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+	Status   string `form:"status" binding:"omitempty,oneof=draft scheduled published archived"`
+}
+
+type listOwnPostsResponse struct {
+	ID           int64      `json:"id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	CategoryName string     `json:"category_name"`
+	Image        string     `json:"image"`
+	Status       string     `json:"status"`
+	PublishAt    *time.Time `json:"publish_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// listOwnPosts lists posts of the authenticated user in every status,
+// optionally filtered by the given status
+func (server *Server) listOwnPosts(ctx *gin.Context) {
+	var request listOwnPostsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	params := db.ListOwnPostsParams{
+		AuthorID: int32(authUser.ID),
+		Status: db.NullPostStatus{
+			PostStatus: db.PostStatus(request.Status),
+			Valid:      request.Status != "",
+		},
+		PageLimit:  request.PageSize,
+		PageOffset: (request.Page - 1) * request.PageSize,
+	}
+
+	posts, err := server.store.ListOwnPosts(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]listOwnPostsResponse, 0, len(posts))
+	for _, post := range posts {
+		res = append(res, listOwnPostsResponse{
+			ID:           post.ID,
+			Title:        post.Title,
+			Description:  post.Description,
+			CategoryName: post.CategoryName,
+			Image:        post.Image,
+			Status:       string(post.Status),
+			PublishAt:    nullTimeToPointer(post.PublishAt),
+			CreatedAt:    post.CreatedAt,
+			UpdatedAt:    post.UpdatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// isPostVisible reports whether the post with the given status can be
+// shown to the requester. Only published posts are public.
+func isPostVisible(ctx *gin.Context, status db.PostStatus, authorEmail string) bool {
+	if status == db.PostStatusPublished {
+		return true
+	}
+	payload, ok := ctx.Get(authorizationPayloadKey)
+	if !ok {
+		return false
+	}
+	authPayload, ok := payload.(*token.Payload)
+	return ok && authPayload.Email == authorEmail
+}
+
+// timeValue returns the time the pointer points to or the zero time
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// newNullTime converts the optional time into sql.NullTime
+func newNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// nullTimeToPointer converts sql.NullTime into an optional time
+func nullTimeToPointer(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+// authorizePostAuthor checks if the authenticated user is the author of the post.
+// On failure, it writes the error response and returns false.
+func (server *Server) authorizePostAuthor(ctx *gin.Context, postID int64) (db.User, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return authUser, false
+	}
+
+	post, err := server.store.GetMinimalPostData(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return authUser, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return authUser, false
+	}
+	if post.AuthorID != int32(authUser.ID) {
+		err := errors.New("post does not belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return authUser, false
+	}
+
+	return authUser, true
+}
+
+type postRevisionsUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type listPostRevisionsRequest struct {
+	Page     int32 `form:"page" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+type postRevisionResponse struct {
+	Revision       int32     `json:"revision"`
+	Title          string    `json:"title"`
+	EditorUsername string    `json:"editor_username"`
+	RestoredFrom   *int32    `json:"restored_from,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// listPostRevisions lists revisions of the post, the newest first.
+// Only the author of the post can see its history.
+func (server *Server) listPostRevisions(ctx *gin.Context) {
+	var uriRequest postRevisionsUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request listPostRevisionsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.authorizePostAuthor(ctx, uriRequest.ID); !ok {
+		return
+	}
+
+	params := db.ListPostRevisionsParams{
+		PostID: uriRequest.ID,
+		Limit:  request.PageSize,
+		Offset: (request.Page - 1) * request.PageSize,
+	}
+
+	revisions, err := server.store.ListPostRevisions(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]postRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		res[i] = postRevisionResponse{
+			Revision:       revision.Revision,
+			Title:          revision.Title,
+			EditorUsername: revision.EditorUsername,
+			RestoredFrom:   nullInt32ToPointer(revision.RestoredFrom),
+			CreatedAt:      revision.CreatedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type diffPostRevisionsRequest struct {
+	From int32 `form:"from" binding:"required,min=1"`
+	To   int32 `form:"to" binding:"required,min=1"`
+}
+
+type diffPostRevisionsResponse struct {
+	From int32  `json:"from"`
+	To   int32  `json:"to"`
+	Diff string `json:"diff"`
+}
+
+// diffPostRevisions returns the unified diff between two revisions of the post
+func (server *Server) diffPostRevisions(ctx *gin.Context) {
+	var uriRequest postRevisionsUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request diffPostRevisionsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.authorizePostAuthor(ctx, uriRequest.ID); !ok {
+		return
+	}
+
+	revisions := make([]db.PostRevision, 2)
+	for i, number := range []int32{request.From, request.To} {
+		revision, err := server.store.GetPostRevision(ctx, db.GetPostRevisionParams{
+			PostID:   uriRequest.ID,
+			Revision: number,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				err := fmt.Errorf("revision %d not found", number)
+				ctx.JSON(http.StatusNotFound, errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		revisions[i] = revision
+	}
+
+	diff, err := utils.UnifiedDiff(
+		fmt.Sprintf("revision %d", request.From),
+		utils.PostDiffText(revisions[0].Title, revisions[0].Description, revisions[0].Content),
+		fmt.Sprintf("revision %d", request.To),
+		utils.PostDiffText(revisions[1].Title, revisions[1].Description, revisions[1].Content),
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := diffPostRevisionsResponse{
+		From: request.From,
+		To:   request.To,
+		Diff: diff,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type restorePostRevisionRequest struct {
+	Revision int32 `json:"revision" binding:"required,min=1"`
+}
+
+type restorePostRevisionResponse struct {
+	ID           int64     `json:"id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	Content      string    `json:"content"`
+	Revision     int32     `json:"revision"`
+	RestoredFrom int32     `json:"restored_from"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// restorePostRevision restores title, description and content of the post
+// from an old revision. The restored version is saved as a new revision.
+func (server *Server) restorePostRevision(ctx *gin.Context) {
+	var uriRequest postRevisionsUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request restorePostRevisionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authUser, ok := server.authorizePostAuthor(ctx, uriRequest.ID)
+	if !ok {
+		return
+	}
+
+	result, err := server.store.RestorePostRevisionTx(ctx, db.RestorePostRevisionTxParams{
+		PostID:   uriRequest.ID,
+		Revision: request.Revision,
+		EditorID: int32(authUser.ID),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := fmt.Errorf("revision %d not found", request.Revision)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := restorePostRevisionResponse{
+		ID:           result.Post.ID,
+		Title:        result.Post.Title,
+		Description:  result.Post.Description,
+		Content:      result.Post.Content,
+		Revision:     result.Revision.Revision,
+		RestoredFrom: request.Revision,
+		UpdatedAt:    result.Post.UpdatedAt,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// nullInt32ToPointer converts sql.NullInt32 into an optional int32
+func nullInt32ToPointer(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int32
+}
@@ -0,0 +1,435 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/blog-go/db/mock"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListPostRevisionsAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+	minimalPost := db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}
+
+	n := 5
+	revisions := make([]db.ListPostRevisionsRow, n)
+	for i := 0; i < n; i++ {
+		revisions[i] = db.ListPostRevisionsRow{
+			ID:             int64(i + 1),
+			Revision:       int32(n - i),
+			Title:          utils.RandomString(6),
+			EditorUsername: randomUser.Username,
+			CreatedAt:      time.Now(),
+		}
+	}
+	revisions[0].RestoredFrom = sql.NullInt32{Int32: 1, Valid: true}
+
+	testCases := []struct {
+		name          string
+		postID        int64
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				params := db.ListPostRevisionsParams{
+					PostID: post.ID,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+				store.EXPECT().
+					ListPostRevisions(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(revisions, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []postRevisionResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got, n)
+				for i := range got {
+					require.Equal(t, revisions[i].Revision, got[i].Revision)
+					require.Equal(t, revisions[i].Title, got[i].Title)
+					require.Equal(t, revisions[i].EditorUsername, got[i].EditorUsername)
+				}
+				require.NotNil(t, got[0].RestoredFrom)
+				require.Equal(t, int32(1), *got[0].RestoredFrom)
+				require.Nil(t, got[1].RestoredFrom)
+			},
+		},
+		{
+			name:   "Not The Author",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID) + 1}, nil)
+				store.EXPECT().ListPostRevisions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "Post Not Found",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{}, sql.ErrNoRows)
+				store.EXPECT().ListPostRevisions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Invalid Post ID",
+			postID: 0,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListPostRevisions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					ListPostRevisions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListPostRevisionsRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/posts/revisions/%d?page=1&page_size=%d", tc.postID, n)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDiffPostRevisionsAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+	minimalPost := db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}
+
+	from := db.PostRevision{
+		PostID:      post.ID,
+		Revision:    1,
+		Title:       "old title",
+		Description: post.Description,
+		Content:     post.Content,
+	}
+	to := db.PostRevision{
+		PostID:      post.ID,
+		Revision:    2,
+		Title:       "new title",
+		Description: post.Description,
+		Content:     post.Content,
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "from=1&to=2",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					GetPostRevision(gomock.Any(), gomock.Eq(db.GetPostRevisionParams{PostID: post.ID, Revision: 1})).
+					Times(1).
+					Return(from, nil)
+				store.EXPECT().
+					GetPostRevision(gomock.Any(), gomock.Eq(db.GetPostRevisionParams{PostID: post.ID, Revision: 2})).
+					Times(1).
+					Return(to, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got diffPostRevisionsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, int32(1), got.From)
+				require.Equal(t, int32(2), got.To)
+				require.Contains(t, got.Diff, "--- revision 1\n+++ revision 2\n")
+				require.Contains(t, got.Diff, "-# old title\n+# new title\n")
+			},
+		},
+		{
+			name:  "Revision Not Found",
+			query: "from=1&to=3",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					GetPostRevision(gomock.Any(), gomock.Eq(db.GetPostRevisionParams{PostID: post.ID, Revision: 1})).
+					Times(1).
+					Return(from, nil)
+				store.EXPECT().
+					GetPostRevision(gomock.Any(), gomock.Eq(db.GetPostRevisionParams{PostID: post.ID, Revision: 3})).
+					Times(1).
+					Return(db.PostRevision{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Missing Revision",
+			query: "from=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetPostRevision(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: "from=1&to=2",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					GetPostRevision(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PostRevision{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/posts/revisions/%d/diff?%s", post.ID, tc.query)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestRestorePostRevisionAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
+	minimalPost := db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}
+
+	result := db.RestorePostRevisionTxResult{
+		Post: post,
+		Revision: db.PostRevision{
+			PostID:       post.ID,
+			Revision:     4,
+			Title:        post.Title,
+			Description:  post.Description,
+			Content:      post.Content,
+			EditorID:     int32(randomUser.ID),
+			RestoredFrom: sql.NullInt32{Int32: 2, Valid: true},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"revision": 2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				params := db.RestorePostRevisionTxParams{
+					PostID:   post.ID,
+					Revision: 2,
+					EditorID: int32(randomUser.ID),
+				}
+				store.EXPECT().
+					RestorePostRevisionTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got restorePostRevisionResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, post.ID, got.ID)
+				require.Equal(t, post.Title, got.Title)
+				require.Equal(t, int32(4), got.Revision)
+				require.Equal(t, int32(2), got.RestoredFrom)
+			},
+		},
+		{
+			name: "Revision Not Found",
+			body: gin.H{"revision": 99},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					RestorePostRevisionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RestorePostRevisionTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Revision",
+			body: gin.H{"revision": 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RestorePostRevisionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not The Author",
+			body: gin.H{"revision": 2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID) + 1}, nil)
+				store.EXPECT().RestorePostRevisionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{"revision": 2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(minimalPost, nil)
+				store.EXPECT().
+					RestorePostRevisionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RestorePostRevisionTxResult{}, sql.ErrTxDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/posts/revisions/%d/restore", post.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
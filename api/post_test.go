@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -43,7 +44,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -62,6 +63,7 @@ func TestCreatePostAPI(t *testing.T) {
 					AuthorID:    post.AuthorID,
 					CategoryID:  post.CategoryID,
 					Image:       post.Image,
+					Status:      db.PostStatusPublished,
 				}
 				store.EXPECT().
 					CreatePost(gomock.Any(), gomock.Eq(params)).
@@ -92,7 +94,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -126,7 +128,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -161,7 +163,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -193,7 +195,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -224,7 +226,7 @@ func TestCreatePostAPI(t *testing.T) {
 				"category":    category.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -276,6 +278,9 @@ func TestCreatePostAPI(t *testing.T) {
 
 func TestDeletePostAPI(t *testing.T) {
 	randomUser, _ := generateRandomUser(t)
+	moderator, _ := generateRandomUser(t)
+	moderator.ID = randomUser.ID + 1
+	moderator.Role = db.UserRoleModerator
 	_, post, _ := generateRandomCategoryPostAndTags(int32(randomUser.ID))
 
 	testCases := []struct {
@@ -289,7 +294,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "OK",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -314,11 +319,40 @@ func TestDeletePostAPI(t *testing.T) {
 				require.Equal(t, http.StatusNoContent, recorder.Code)
 			},
 		},
+		{
+			name:   "OK Moderator",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, moderator.Email, utils.ModeratorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(moderator.Email)).
+					Times(1).
+					Return(moderator, nil)
+
+				data := db.GetMinimalPostDataRow{
+					ID:       post.ID,
+					AuthorID: post.AuthorID,
+				}
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(data, nil)
+				store.EXPECT().
+					DeletePost(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
 		{
 			name:   "Invalid Post ID",
 			postID: 0,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -339,7 +373,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "Internal Server Error GetUser",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -361,7 +395,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "Internal Server Error GetMinimalPostData",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -384,7 +418,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "Internal Server Error DeletePost",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -412,7 +446,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "Not Found",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -435,7 +469,7 @@ func TestDeletePostAPI(t *testing.T) {
 			name:   "Unauthorized User",
 			postID: post.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -498,10 +532,14 @@ func TestGetPostByIDAPI(t *testing.T) {
 		Description:    post.Description,
 		Content:        post.Content,
 		AuthorUsername: randomUser.Username,
+		AuthorEmail:    randomUser.Email,
 		CategoryName:   category.Name,
 		Image:          post.Image,
+		Status:         db.PostStatusPublished,
 		CreatedAt:      post.CreatedAt,
 	}
+	draft := data
+	draft.Status = db.PostStatusDraft
 	tags := []db.Tag{
 		{ID: 1, Name: utils.RandomString(3)},
 		{ID: 2, Name: utils.RandomString(4)},
@@ -510,9 +548,65 @@ func TestGetPostByIDAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		postID        int64
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
+		{
+			name:   "Draft Not Found For Anonymous",
+			postID: post.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByID(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Draft Not Found For Other User",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, utils.RandomEmail(), utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByID(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Draft Visible To Author",
+			postID: post.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByID(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(tags, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
 		{
 			name:   "OK",
 			postID: post.ID,
@@ -612,6 +706,9 @@ func TestGetPostByIDAPI(t *testing.T) {
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
+			if tc.setupAuth != nil {
+				tc.setupAuth(t, req, server.tokenMaker)
+			}
 			server.router.ServeHTTP(recorder, req)
 
 			tc.checkResponse(recorder)
@@ -628,10 +725,14 @@ func TestGetPostByTitleAPI(t *testing.T) {
 		Description:    post.Description,
 		Content:        post.Content,
 		AuthorUsername: randomUser.Username,
+		AuthorEmail:    randomUser.Email,
 		CategoryName:   category.Name,
 		Image:          post.Image,
+		Status:         db.PostStatusPublished,
 		CreatedAt:      post.CreatedAt,
 	}
+	draft := data
+	draft.Status = db.PostStatusDraft
 	tags := []db.Tag{
 		{ID: 1, Name: utils.RandomString(3)},
 		{ID: 2, Name: utils.RandomString(4)},
@@ -640,9 +741,65 @@ func TestGetPostByTitleAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		postTitle     string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
+		{
+			name:      "Draft Not Found For Anonymous",
+			postTitle: post.Title,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByTitle(gomock.Any(), gomock.Eq(post.Title)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "Draft Not Found For Other User",
+			postTitle: post.Title,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, utils.RandomEmail(), utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByTitle(gomock.Any(), gomock.Eq(post.Title)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "Draft Visible To Author",
+			postTitle: post.Title,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPostByTitle(gomock.Any(), gomock.Eq(post.Title)).
+					Times(1).
+					Return(draft, nil)
+				store.EXPECT().
+					GetTagsOfPost(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(tags, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
 		{
 			name:      "OK",
 			postTitle: post.Title,
@@ -744,6 +901,9 @@ func TestGetPostByTitleAPI(t *testing.T) {
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
+			if tc.setupAuth != nil {
+				tc.setupAuth(t, req, server.tokenMaker)
+			}
 			server.router.ServeHTTP(recorder, req)
 
 			tc.checkResponse(recorder)
@@ -1353,7 +1513,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"description": "new description",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).AnyTimes().Return(randomUser, nil)
@@ -1363,17 +1523,108 @@ func TestUpdatePostAPI(t *testing.T) {
 					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Eq(post.ID)).AnyTimes().Return(getPostByIdRow, nil)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).AnyTimes().Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).AnyTimes().Return(post, nil)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Eq(post.ID)).AnyTimes().Return([]db.Tag{}, nil)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Eq(post.ID)).AnyTimes().Return([]db.Tag{}, nil)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).AnyTimes().Return(db.UpdatePostTxResult{Post: post}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 				requireBodyMatchUpdatedPost(t, recorder.Body, post)
 			},
 		},
+		{
+			name:   "OK Replace Tags",
+			postID: post.ID,
+			body: gin.H{
+				"tags": []string{"tag1", "tag2"},
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					Times(1).
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
+				store.EXPECT().GetPostByID(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return(getPostByIdRow, nil)
+				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(1).Return(category.ID, nil)
+				store.EXPECT().
+					UpdatePostTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.UpdatePostTxParams) (db.UpdatePostTxResult, error) {
+						require.Equal(t, []string{"tag1", "tag2"}, arg.Tags)
+						require.False(t, arg.Status.Valid)
+						return db.UpdatePostTxResult{
+							Post: post,
+							Tags: []db.Tag{{ID: 1, Name: "tag1"}, {ID: 2, Name: "tag2"}},
+						}, nil
+					})
+				// the tags are changed in the same transaction as the post
+				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res updatePostResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, []string{"tag1", "tag2"}, res.Tags)
+			},
+		},
+		{
+			name:   "OK Publish Draft",
+			postID: post.ID,
+			body: gin.H{
+				"status": "published",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).AnyTimes().Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					AnyTimes().
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
+				store.EXPECT().GetPostByID(gomock.Any(), gomock.Eq(post.ID)).AnyTimes().Return(getPostByIdRow, nil)
+				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).AnyTimes().Return(category.ID, nil)
+				store.EXPECT().
+					UpdatePostTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.UpdatePostTxParams) (db.UpdatePostTxResult, error) {
+						require.Equal(t, db.NullPostStatus{PostStatus: db.PostStatusPublished, Valid: true}, arg.Status)
+						require.False(t, arg.PublishAt.Valid)
+						require.Nil(t, arg.Tags)
+						return db.UpdatePostTxResult{Post: post}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchUpdatedPost(t, recorder.Body, post)
+			},
+		},
+		{
+			name:   "Scheduled Without Publish At",
+			postID: post.ID,
+			body: gin.H{
+				"status": "scheduled",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).AnyTimes().Return(randomUser, nil)
+				store.EXPECT().
+					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					AnyTimes().
+					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
+				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name:   "Invalid Post ID",
 			postID: 0,
@@ -1382,18 +1633,14 @@ func TestUpdatePostAPI(t *testing.T) {
 				"description": "new description",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetMinimalPostData(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -1404,7 +1651,7 @@ func TestUpdatePostAPI(t *testing.T) {
 			postID: post.ID,
 			body:   gin.H{},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).AnyTimes().Return(randomUser, nil)
@@ -1414,11 +1661,7 @@ func TestUpdatePostAPI(t *testing.T) {
 					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -1432,7 +1675,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"description": "new description",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq("unauthorized@example.com")).
@@ -1449,11 +1692,7 @@ func TestUpdatePostAPI(t *testing.T) {
 					Return(db.GetMinimalPostDataRow{ID: post.ID, AuthorID: int32(randomUser.ID)}, nil)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -1467,7 +1706,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"category": "newCategory",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
@@ -1477,11 +1716,7 @@ func TestUpdatePostAPI(t *testing.T) {
 					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).Times(0)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -1495,7 +1730,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"category": "newCategory",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
@@ -1512,11 +1747,7 @@ func TestUpdatePostAPI(t *testing.T) {
 					Times(1).
 					Return(db.GetPostByIDRow{}, sql.ErrConnDone)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -1530,7 +1761,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"category": "newCategory",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
@@ -1547,11 +1778,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(int64(0), sql.ErrConnDone)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -1565,7 +1792,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"category": "newCategory",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
@@ -1577,11 +1804,7 @@ func TestUpdatePostAPI(t *testing.T) {
 					Return(db.GetMinimalPostDataRow{}, sql.ErrConnDone)
 				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -1596,7 +1819,7 @@ func TestUpdatePostAPI(t *testing.T) {
 				"image":    "test.jpg",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
@@ -1613,178 +1836,381 @@ func TestUpdatePostAPI(t *testing.T) {
 				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.Post{}, sql.ErrConnDone)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+					Return(db.UpdatePostTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name:   "Internal Server Error GetTagsOfPost1",
+			name:   "Not Found",
 			postID: post.ID,
 			body: gin.H{
-				"title":    "new title",
-				"category": "newCategory",
+				"title": "new title",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
 				store.EXPECT().
 					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
 					Times(1).
-					Return(db.GetMinimalPostDataRow{
-						ID:       post.ID,
-						AuthorID: int32(randomUser.ID),
-					}, nil)
-				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(1).Return(getPostByIdRow, nil)
-				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(1).Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(1).Return(post, nil)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).
+					Return(db.GetMinimalPostDataRow{}, sql.ErrNoRows)
+				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePostTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/posts/%d", tc.postID)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSearchPostsAPI(t *testing.T) {
+	n := 5
+	searchQuery := utils.RandomString(6)
+	posts := make([]db.SearchPostsRow, n)
+	for i := 0; i < n; i++ {
+		posts[i] = db.SearchPostsRow{
+			ID:               int64(i + 1),
+			Title:            utils.RandomString(5),
+			Description:      utils.RandomString(5),
+			AuthorUsername:   utils.RandomString(5),
+			CategoryName:     utils.RandomString(5),
+			Image:            utils.RandomString(5) + "jpg",
+			CreatedAt:        time.Now(),
+			Rank:             float32(n - i),
+			HighlightedTitle: utils.RandomString(5),
+			Snippet:          fmt.Sprintf("<mark>%s</mark>", searchQuery),
+		}
+	}
+
+	type Query struct {
+		query    string
+		page     int
+		pageSize int
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			query: Query{
+				query:    searchQuery,
+				page:     1,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountSearchPosts(gomock.Any(), gomock.Eq(searchQuery)).
 					Times(1).
-					Return([]db.Tag{}, sql.ErrConnDone)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+					Return(int64(n), nil)
+				params := db.SearchPostsParams{
+					Query:      searchQuery,
+					PageLimit:  int32(n),
+					PageOffset: 0,
+				}
+				store.EXPECT().
+					SearchPosts(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(posts, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res searchPostsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, int64(n), res.Total)
+				require.Len(t, res.Posts, n)
+				for i, post := range res.Posts {
+					require.Equal(t, posts[i].ID, post.ID)
+					require.Equal(t, posts[i].Title, post.Title)
+					require.Equal(t, posts[i].Rank, post.Rank)
+					require.Equal(t, posts[i].HighlightedTitle, post.HighlightedTitle)
+					require.Equal(t, posts[i].Snippet, post.Snippet)
+				}
 			},
 		},
 		{
-			name:   "Internal Server Error AddTagsToPost",
-			postID: post.ID,
-			body: gin.H{
-				"title": "new title",
-				"tags":  []string{"tag1", "tag2"},
+			name: "Internal Server Error Count",
+			query: Query{
+				query:    searchQuery,
+				page:     1,
+				pageSize: n,
 			},
-			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountSearchPosts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+				store.EXPECT().
+					SearchPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error Search",
+			query: Query{
+				query:    searchQuery,
+				page:     1,
+				pageSize: n,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
 				store.EXPECT().
-					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					CountSearchPosts(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.GetMinimalPostDataRow{
-						ID:       post.ID,
-						AuthorID: int32(randomUser.ID),
-					}, nil)
-				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(1).Return(getPostByIdRow, nil)
-				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(1).Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(1).Return(post, nil)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(1).Return([]db.Tag{}, nil)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).
+					Return(int64(n), nil)
+				store.EXPECT().
+					SearchPosts(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+					Return([]db.SearchPostsRow{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name:   "Internal Server Error RemoveTagsFromPost",
-			postID: post.ID,
-			body: gin.H{
-				"title": "new title",
-				"tags":  []string{"tag1", "tag2"},
+			name: "Missing Query",
+			query: Query{
+				query:    "",
+				page:     1,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountSearchPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					SearchPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Page Size",
+			query: Query{
+				query:    searchQuery,
+				page:     1,
+				pageSize: 99,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountSearchPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					SearchPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := "/posts/search"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			q.Add("query", tc.query.query)
+			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListOwnPostsAPI(t *testing.T) {
+	randomUser, _ := generateRandomUser(t)
+	n := 5
+	posts := make([]db.ListOwnPostsRow, n)
+	for i := 0; i < n; i++ {
+		posts[i] = db.ListOwnPostsRow{
+			ID:           int64(i + 1),
+			Title:        utils.RandomString(5),
+			Description:  utils.RandomString(5),
+			CategoryName: utils.RandomString(5),
+			Image:        utils.RandomString(5) + "jpg",
+			Status:       db.PostStatusDraft,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		}
+	}
+
+	type Query struct {
+		page     int
+		pageSize int
+		status   string
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			query: Query{
+				page:     1,
+				pageSize: n,
+				status:   "draft",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
 				store.EXPECT().
-					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
-					Times(1).
-					Return(db.GetMinimalPostDataRow{
-						ID:       post.ID,
-						AuthorID: int32(randomUser.ID),
-					}, nil)
-				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(1).Return(getPostByIdRow, nil)
-				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(1).Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(1).Return(post, nil)
-				// must return tags so that the RemoveTagsFromPost is called
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).
+					GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).
 					Times(1).
-					Return([]db.Tag{
-						{ID: 11, Name: "tag11"},
-					}, nil)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(1).Return(nil)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).
+					Return(randomUser, nil)
+				params := db.ListOwnPostsParams{
+					AuthorID: int32(randomUser.ID),
+					Status: db.NullPostStatus{
+						PostStatus: db.PostStatusDraft,
+						Valid:      true,
+					},
+					PageLimit:  int32(n),
+					PageOffset: 0,
+				}
+				store.EXPECT().
+					ListOwnPosts(gomock.Any(), gomock.Eq(params)).
 					Times(1).
-					Return(sql.ErrConnDone)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+					Return(posts, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []listOwnPostsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got, n)
+				for i := range got {
+					require.Equal(t, posts[i].Title, got[i].Title)
+					require.Equal(t, string(posts[i].Status), got[i].Status)
+					require.Nil(t, got[i].PublishAt)
+				}
 			},
 		},
 		{
-			name:   "Internal Server Error RemoveTagsFromPost",
-			postID: post.ID,
-			body: gin.H{
-				"title": "new title",
-				"tags":  []string{"tag1", "tag2"},
+			name: "Invalid Status",
+			query: Query{
+				page:     1,
+				pageSize: n,
+				status:   "invalid",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
 				store.EXPECT().
-					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
-					Times(1).
-					Return(db.GetMinimalPostDataRow{
-						ID:       post.ID,
-						AuthorID: int32(randomUser.ID),
-					}, nil)
-				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(1).Return(getPostByIdRow, nil)
-				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(1).Return(category.ID, nil)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(1).Return(post, nil)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(1).Return([]db.Tag{}, nil)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(1).Return(nil)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Eq(post.ID)).Times(1).Return([]db.Tag{}, sql.ErrConnDone)
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ListOwnPosts(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:   "Not Found",
-			postID: post.ID,
-			body: gin.H{
-				"title": "new title",
+			name: "Unauthorized",
+			query: Query{
+				page:     1,
+				pageSize: n,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(randomUser.Email)).Times(1).Return(randomUser, nil)
 				store.EXPECT().
-					GetMinimalPostData(gomock.Any(), gomock.Eq(post.ID)).
+					ListOwnPosts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			query: Query{
+				page:     1,
+				pageSize: n,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, randomUser.Email, utils.AuthorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.GetMinimalPostDataRow{}, sql.ErrNoRows)
-				store.EXPECT().GetPostByID(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetOrCreateCategory(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().AddTagsToPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().RemoveTagsFromPost(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().GetTagsOfPost(gomock.Any(), gomock.Any()).Times(0)
+					Return(randomUser, nil)
+				store.EXPECT().
+					ListOwnPosts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListOwnPostsRow{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 	}
@@ -1801,15 +2227,20 @@ func TestUpdatePostAPI(t *testing.T) {
 			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
-			data, err := json.Marshal(tc.body)
+			url := "/posts/mine"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
-			url := fmt.Sprintf("/posts/%d", tc.postID)
-			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
-			require.NoError(t, err)
+			// Add query params
+			q := req.URL.Query()
+			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			if tc.query.status != "" {
+				q.Add("status", tc.query.status)
+			}
+			req.URL.RawQuery = q.Encode()
 
 			tc.setupAuth(t, req, server.tokenMaker)
-
 			server.router.ServeHTTP(recorder, req)
 
 			tc.checkResponse(recorder)
@@ -1832,6 +2263,7 @@ func generateRandomCategoryPostAndTags(userID int32) (db.Category, db.Post, []st
 		AuthorID:    userID,
 		CategoryID:  int32(category.ID),
 		Image:       fmt.Sprintf("%s.jpg", utils.RandomString(3)),
+		Status:      db.PostStatusPublished,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -1,34 +1,71 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/lockout"
+	"github.com/aalug/blog-go/metrics"
+	"github.com/aalug/blog-go/ratelimit"
+	"github.com/aalug/blog-go/sso"
 	"github.com/aalug/blog-go/token"
 	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/worker"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"log"
+	"net"
+	"net/http"
 )
 
 // Server serves HTTP  requests for the service
 type Server struct {
-	config     utils.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config          utils.Config
+	store           db.Store
+	tokenMaker      token.Maker
+	router          *gin.Engine
+	taskDistributor worker.TaskDistributor
+	passwordChanges *token.PasswordChangeCache
+	oidcProvider    *sso.Provider
+	loginLimiter    *lockout.Limiter
+	rateLimiter     *ratelimit.Limiter
+	trustedProxies  []*net.IPNet
 }
 
 // NewServer creates a new HTTP server and setups routing
-func NewServer(config utils.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(config utils.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
+
+	rateLimiter, err := ratelimit.NewLimiterFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate limiter: %w", err)
+	}
+
+	trustedProxies, err := utils.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse trusted proxies: %w", err)
+	}
+
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:          config,
+		store:           store,
+		tokenMaker:      tokenMaker,
+		taskDistributor: taskDistributor,
+		passwordChanges: token.NewPasswordChangeCache(store, config.PasswordChangeCacheDuration),
+		loginLimiter:    lockout.NewLimiter(store, config),
+		rateLimiter:     rateLimiter,
+		trustedProxies:  trustedProxies,
+	}
+
+	// OIDC login is optional, its endpoints respond with 404 if it is not configured
+	server.oidcProvider, err = sso.NewProvider(context.Background(), config)
+	if err != nil && !errors.Is(err, sso.ErrNotConfigured) {
+		return nil, fmt.Errorf("cannot create OIDC provider: %w", err)
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -41,6 +78,11 @@ func NewServer(config utils.Config, store db.Store) (*Server, error) {
 		if err != nil {
 			log.Fatal("failed to register validation")
 		}
+
+		err = v.RegisterValidation("role", isValidRole)
+		if err != nil {
+			log.Fatal("failed to register validation")
+		}
 	}
 
 	server.setupRouter()
@@ -52,44 +94,90 @@ func NewServer(config utils.Config, store db.Store) (*Server, error) {
 func (server *Server) setupRouter() {
 	router := gin.Default()
 
+	// ctx.ClientIP() takes the right-most x-forwarded-for hop not added by a trusted proxy,
+	// loopback is always trusted like by the gRPC server
+	trustedProxies := []string{"127.0.0.0/8", "::1/128"}
+	for _, network := range server.trustedProxies {
+		trustedProxies = append(trustedProxies, network.String())
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatal("failed to set trusted proxies")
+	}
+	router.RemoteIPHeaders = []string{"X-Forwarded-For"}
+
+	router.Use(requestIDMiddleware())
+	router.Use(metricsMiddleware())
+	router.Use(rateLimitMiddleware(server.rateLimiter, server.tokenMaker))
+
 	// --- users ---
 	router.POST("/users", server.createUser)
 	router.POST("/users/login", server.loginUser)
+	router.POST("/users/login/two_factor", server.loginTwoFactor)
+	router.GET("/users/oidc/login", server.oidcLogin)
+	router.POST("/users/oidc/callback", server.oidcCallback)
 
 	// tokens/sessions
 	router.POST("/tokens/renew", server.renewAccessToken)
+	router.GET("/.well-known/jwks.json", server.getJWKS)
 
 	// --- categories ---
 	router.GET("/category", server.listCategories)
 
 	// --- posts ---
-	router.GET("/posts/id/:id", server.getPostByID)
-	router.GET("/posts/title/:slug", server.getPostByTitle)
+	optionalAuthRoutes := router.Group("/").Use(optionalAuthMiddleware(server.tokenMaker, server.passwordChanges))
+	optionalAuthRoutes.GET("/posts/id/:id", server.getPostByID)
+	optionalAuthRoutes.GET("/posts/title/:slug", server.getPostByTitle)
 	router.GET("/posts/all", server.listPosts)
 	router.GET("/posts/author", server.listPostsByAuthor)
 	router.GET("/posts/category", server.listPostsByCategory)
 	router.GET("/posts/tags", server.listPostsByTags)
+	router.GET("/posts/search", server.searchPosts)
 
 	// --- comments ---
 	router.GET("/comments/:post_id", server.listComments)
+	router.GET("/comments/:post_id/tree", server.listCommentTree)
+	router.GET("/comments/replies/:id", server.listCommentReplies)
 
 	// ===== routes that require authentication =====
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.passwordChanges, utils.ReaderRole))
+	authorRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.passwordChanges, utils.AuthorRole))
+	moderatorRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.passwordChanges, utils.ModeratorRole))
+	adminRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.passwordChanges, utils.AdminRole))
+
+	// --- users ---
+	adminRoutes.PATCH("/users/role", server.updateUserRole)
+	authRoutes.POST("/users/two_factor/enroll", server.enrollTwoFactor)
+	authRoutes.POST("/users/two_factor/verify", server.verifyTwoFactor)
+
+	// tokens/sessions
+	authRoutes.GET("/users/sessions", server.listSessions)
+	authRoutes.DELETE("/users/sessions/:id", server.revokeSession)
+	authRoutes.POST("/users/sessions/revoke_others", server.revokeOtherSessions)
+	authRoutes.POST("/users/logout", server.logoutUser)
 
 	// --- categories ---
-	authRoutes.POST("/category", server.createCategory)
-	authRoutes.DELETE("/category/:name", server.deleteCategory)
-	authRoutes.PATCH("/category", server.updateCategory)
+	adminRoutes.POST("/category", server.createCategory)
+	adminRoutes.DELETE("/category/:name", server.deleteCategory)
+	adminRoutes.PATCH("/category", server.updateCategory)
 
 	// --- posts ---
-	authRoutes.POST("/posts", server.createPost)
+	authorRoutes.POST("/posts", server.createPost)
 	authRoutes.DELETE("/posts/:id", server.deletePost)
 	authRoutes.PATCH("/posts/:id", server.updatePost)
+	authRoutes.GET("/posts/mine", server.listOwnPosts)
+	authRoutes.GET("/posts/revisions/:id", server.listPostRevisions)
+	authRoutes.GET("/posts/revisions/:id/diff", server.diffPostRevisions)
+	authRoutes.POST("/posts/revisions/:id/restore", server.restorePostRevision)
 
 	// --- comments ---
 	authRoutes.POST("/comments", server.createComment)
 	authRoutes.DELETE("/comments/:id", server.deleteComment)
 	authRoutes.PATCH("/comments/:id", server.updateComment)
+	moderatorRoutes.GET("/comments/moderation", server.listModerationQueue)
+	moderatorRoutes.POST("/comments/moderation", server.moderateComments)
+
+	// --- metrics ---
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 
 	server.router = router
 }
@@ -99,6 +187,11 @@ func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
 
+// Handler returns the HTTP handler of the server, so it can be served by an http.Server
+func (server *Server) Handler() http.Handler {
+	return server.router
+}
+
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
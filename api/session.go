@@ -0,0 +1,174 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// listSessions lists active (not blocked and not expired)
+// sessions of the authenticated user, the newest first
+func (server *Server) listSessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	sessions, err := server.store.ListActiveSessions(ctx, authPayload.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]sessionResponse, len(sessions))
+	for i, session := range sessions {
+		res[i] = sessionResponse{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			ClientIP:  session.ClientIp,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type revokeSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// revokeSession blocks a session (with its whole family) of the
+// authenticated user, so its refresh token can no longer be used
+func (server *Server) revokeSession(ctx *gin.Context) {
+	var request revokeSessionRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	blocked, err := server.store.BlockSession(ctx, db.BlockSessionParams{
+		ID:    uuid.MustParse(request.ID),
+		Email: authPayload.Email,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if blocked == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("session not found")))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type currentSessionRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// logoutUser blocks the session (with its whole family) of the given refresh token
+func (server *Server) logoutUser(ctx *gin.Context) {
+	var request currentSessionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.RefreshToken)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	if refreshPayload.Email != authPayload.Email {
+		err := errors.New("session does not belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	blocked, err := server.store.BlockSession(ctx, db.BlockSessionParams{
+		ID:    refreshPayload.ID,
+		Email: authPayload.Email,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if blocked == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("session not found")))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type revokeOtherSessionsResponse struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
+
+// revokeOtherSessions blocks all sessions of the authenticated user except
+// the current one (the family of the session of the given refresh token)
+func (server *Server) revokeOtherSessions(ctx *gin.Context) {
+	var request currentSessionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.RefreshToken)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("session not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// only an active session of the authenticated user can revoke the others
+	if session.Email != authPayload.Email || session.RefreshToken != request.RefreshToken {
+		err := errors.New("session does not belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	if session.IsBlocked {
+		err := errors.New("blocked session")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	revoked, err := server.store.BlockOtherSessions(ctx, db.BlockOtherSessionsParams{
+		Email:           authPayload.Email,
+		CurrentFamilyID: session.FamilyID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeOtherSessionsResponse{RevokedSessions: revoked})
+}
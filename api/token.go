@@ -2,11 +2,15 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type renewAccessTokenRequest struct {
@@ -14,11 +18,17 @@ type renewAccessTokenRequest struct {
 }
 
 type renewAccessTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
-// renewAccessToken re-generates an access token
+// renewAccessToken re-generates an access token and rotates the refresh token.
+// The new refresh token belongs to the same session family and expires with it.
+// If an already rotated refresh token is used again, it may have been stolen,
+// so the whole family is blocked.
 func (server *Server) renewAccessToken(ctx *gin.Context) {
 	var req renewAccessTokenRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -42,6 +52,11 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	if session.ReplacedBy.Valid {
+		server.blockSessionFamily(ctx, session.FamilyID)
+		return
+	}
+
 	if session.IsBlocked {
 		err := fmt.Errorf("blocked session")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
@@ -66,8 +81,21 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	// the role is read again, so role changes apply to renewed access tokens
+	user, err := server.store.GetUser(ctx, session.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if refreshPayload.IssuedBefore(user.PasswordChangedAt) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(token.ErrRevokedToken))
+		return
+	}
+
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
-		refreshPayload.Email,
+		user.Email,
+		string(user.Role),
 		server.config.AccessTokenDuration,
 	)
 	if err != nil {
@@ -75,9 +103,58 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(
+		user.Email,
+		string(user.Role),
+		time.Until(session.ExpiresAt),
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	txResult, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		ID: session.ID,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			Email:        user.Email,
+			RefreshToken: refreshToken,
+			UserAgent:    ctx.Request.UserAgent(),
+			ClientIp:     ctx.ClientIP(),
+			IsBlocked:    false,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+			FamilyID:     session.FamilyID,
+		},
+	})
+	if err != nil {
+		// the session has been rotated or blocked by a concurrent request
+		if err == sql.ErrNoRows {
+			server.blockSessionFamily(ctx, session.FamilyID)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	rsp := renewAccessTokenResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+		SessionID:             txResult.Session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: newRefreshPayload.ExpiredAt,
 	}
 	ctx.JSON(http.StatusOK, rsp)
 }
+
+// blockSessionFamily blocks all sessions of the family after
+// a reuse of a rotated refresh token and responds with 401
+func (server *Server) blockSessionFamily(ctx *gin.Context, familyID uuid.UUID) {
+	err := server.store.BlockSessionFamily(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = errors.New("refresh token reuse detected, the session has been revoked")
+	ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	mockdb "github.com/aalug/blog-go/db/mock"
@@ -31,17 +32,19 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
 				require.NotEmpty(t, refreshToken)
 				require.NotEmpty(t, refreshPayload)
 				session := db.Session{
-					ID:           uuid.UUID{},
+					ID:           refreshPayload.ID,
 					Email:        user.Email,
 					RefreshToken: refreshToken,
 					IsBlocked:    false,
 					ExpiresAt:    time.Now().Add(time.Minute),
+					FamilyID:     refreshPayload.ID,
 				}
 				return gin.H{
 					"refresh_token": refreshToken,
@@ -52,9 +55,113 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
 					Times(1).
 					Return(session, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(session.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RotateSessionTxParams) (db.RotateSessionTxResult, error) {
+						require.Equal(t, session.ID, arg.ID)
+						require.Equal(t, session.FamilyID, arg.NewSession.FamilyID)
+						require.NotEqual(t, session.RefreshToken, arg.NewSession.RefreshToken)
+						require.WithinDuration(t, session.ExpiresAt, arg.NewSession.ExpiresAt, time.Second)
+
+						return db.RotateSessionTxResult{Session: db.Session{ID: arg.NewSession.ID}}, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res renewAccessTokenResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.NotEmpty(t, res.RefreshToken)
+				require.NotEqual(t, uuid.Nil, res.SessionID)
+			},
+		},
+		{
+			name: "Reused Refresh Token",
+			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
+				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
+					user.Email,
+					string(user.Role),
+					time.Minute,
+				)
+				require.NoError(t, err)
+				session := db.Session{
+					ID:           refreshPayload.ID,
+					Email:        user.Email,
+					RefreshToken: refreshToken,
+					IsBlocked:    false,
+					ExpiresAt:    time.Now().Add(time.Minute),
+					FamilyID:     uuid.New(),
+					ReplacedBy:   uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				}
+				return gin.H{
+					"refresh_token": refreshToken,
+				}, session, refreshPayload
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session, refreshPayload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+				store.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Concurrently Rotated Session",
+			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
+				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
+					user.Email,
+					string(user.Role),
+					time.Minute,
+				)
+				require.NoError(t, err)
+				session := db.Session{
+					ID:           refreshPayload.ID,
+					Email:        user.Email,
+					RefreshToken: refreshToken,
+					IsBlocked:    false,
+					ExpiresAt:    time.Now().Add(time.Minute),
+					FamilyID:     uuid.New(),
+				}
+				return gin.H{
+					"refresh_token": refreshToken,
+				}, session, refreshPayload
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session, refreshPayload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(session.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RotateSessionTxResult{}, sql.ErrNoRows)
+				store.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
 		{
@@ -62,6 +169,7 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
@@ -92,6 +200,7 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
@@ -123,6 +232,7 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
@@ -154,6 +264,7 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
@@ -184,6 +295,7 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
 				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 					user.Email,
+					string(user.Role),
 					time.Minute,
 				)
 				require.NoError(t, err)
@@ -210,6 +322,42 @@ func TestRenewAccessTokenAPI(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name: "Password Changed After Login",
+			body: func(server *Server) (gin.H, db.Session, *token.Payload) {
+				refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
+					user.Email,
+					string(user.Role),
+					time.Minute,
+				)
+				require.NoError(t, err)
+				session := db.Session{
+					ID:           refreshPayload.ID,
+					Email:        user.Email,
+					RefreshToken: refreshToken,
+					IsBlocked:    false,
+					ExpiresAt:    time.Now().Add(time.Minute),
+				}
+				return gin.H{
+					"refresh_token": refreshToken,
+				}, session, refreshPayload
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session, refreshPayload *token.Payload) {
+				changedUser := user
+				changedUser.PasswordChangedAt = time.Now().Add(time.Second)
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(session.Email)).
+					Times(1).
+					Return(changedUser, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 	}
 	for i := range testCases {
 		tc := testCases[i]
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type twoFactorChallengeResponse struct {
+	TwoFactorRequired       bool      `json:"two_factor_required"`
+	TwoFactorToken          uuid.UUID `json:"two_factor_token"`
+	TwoFactorTokenExpiresAt time.Time `json:"two_factor_token_expires_at"`
+}
+
+type enrollTwoFactorResponse struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioning_uri"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+}
+
+// enrollTwoFactor generates a new TOTP secret and recovery codes of the authenticated user.
+// The two-factor authentication is enabled only after a code is confirmed with verifyTwoFactor.
+// Enrolling again before that replaces the secret and the recovery codes.
+func (server *Server) enrollTwoFactor(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	secret, provisioningURI, err := utils.GenerateTOTPKey(server.config.TwoFactorIssuer, authPayload.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	recoveryCodes, err := utils.GenerateRecoveryCodes(utils.RecoveryCodesCount)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedRecoveryCodes[i] = utils.HashRecoveryCode(code)
+	}
+
+	_, err = server.store.EnrollTwoFactorTx(ctx, db.EnrollTwoFactorTxParams{
+		Email:               authPayload.Email,
+		Secret:              secret,
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("two-factor authentication is already enabled")
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := enrollTwoFactorResponse{
+		Secret:          secret,
+		ProvisioningURI: provisioningURI,
+		RecoveryCodes:   recoveryCodes,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type verifyTwoFactorRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+// verifyTwoFactor enables the two-factor authentication of the authenticated user
+// after the user confirms a TOTP code generated with the enrolled secret
+func (server *Server) verifyTwoFactor(ctx *gin.Context) {
+	var request verifyTwoFactorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, authPayload.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("two-factor authentication is not enrolled")
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if twoFactorSecret.IsEnabled {
+		err := errors.New("two-factor authentication is already enabled")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	step, ok := utils.TOTPCodeStep(request.Code, twoFactorSecret.Secret, time.Now())
+	if !ok {
+		err := errors.New("invalid two-factor code")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	// the code cannot be used again to log in
+	_, err = server.store.EnableTwoFactorSecret(ctx, db.EnableTwoFactorSecretParams{
+		Email:        authPayload.Email,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("two-factor authentication is already enabled")
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type loginTwoFactorRequest struct {
+	TwoFactorToken string `json:"two_factor_token" binding:"required,uuid"`
+	Code           string `json:"code" binding:"required,min=6,max=11"`
+}
+
+// loginTwoFactor completes the login of a user with the two-factor authentication enabled.
+// It takes the two-factor token from loginUser and either a TOTP code or an unused recovery code.
+func (server *Server) loginTwoFactor(ctx *gin.Context) {
+	var request loginTwoFactorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the attempt is counted before the code is checked, so concurrent requests
+	// cannot check more codes than MaxTwoFactorAttempts
+	challenge, err := server.store.IncrementTwoFactorChallengeAttempts(ctx, db.IncrementTwoFactorChallengeAttemptsParams{
+		ID:          uuid.MustParse(request.TwoFactorToken),
+		MaxAttempts: utils.MaxTwoFactorAttempts,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("two-factor token is invalid, used or expired")
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if server.isLoginLocked(ctx, challenge.Email) {
+		return
+	}
+
+	valid, err := server.checkTwoFactorCode(ctx, challenge.Email, request.Code)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// a password login issues a new challenge, so the invalid codes are limited
+	// per account and per client IP like the invalid passwords
+	if !valid {
+		if err := server.failLogin(ctx, challenge.Email); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		err = errors.New("invalid two-factor code")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	// the token can be used only once, even by concurrent requests
+	_, err = server.store.UseTwoFactorChallenge(ctx, challenge.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := errors.New("two-factor token is used or expired")
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.loginLimiter.Succeed(ctx, challenge.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.GetUser(ctx, challenge.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// the user could have been disabled after the first step
+	if user.IsDisabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("account is disabled")))
+		return
+	}
+
+	res, err := server.createUserSession(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// isTwoFactorEnabled checks if the user has the two-factor authentication enabled
+func (server *Server) isTwoFactorEnabled(ctx *gin.Context, email string) (bool, error) {
+	twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return twoFactorSecret.IsEnabled, nil
+}
+
+// checkTwoFactorCode checks the TOTP code of the user or uses the recovery code,
+// so the same TOTP code or recovery code cannot be used again
+func (server *Server) checkTwoFactorCode(ctx *gin.Context, email, code string) (bool, error) {
+	if utils.IsTOTPCode(code) {
+		twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, email)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, err
+		}
+
+		step, ok := utils.TOTPCodeStep(code, twoFactorSecret.Secret, time.Now())
+		if !twoFactorSecret.IsEnabled || !ok {
+			return false, nil
+		}
+
+		// the code can be used only once, even by concurrent requests
+		_, err = server.store.UseTwoFactorStep(ctx, db.UseTwoFactorStepParams{
+			Email: email,
+			Step:  step,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	_, err := server.store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		Email:      email,
+		HashedCode: utils.HashRecoveryCode(code),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,575 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	mockdb "github.com/aalug/blog-go/db/mock"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnrollTwoFactorAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, string(user.Role), time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EnrollTwoFactorTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.EnrollTwoFactorTxParams) (db.EnrollTwoFactorTxResult, error) {
+						require.Equal(t, user.Email, arg.Email)
+						require.NotEmpty(t, arg.Secret)
+						require.Len(t, arg.HashedRecoveryCodes, utils.RecoveryCodesCount)
+
+						return db.EnrollTwoFactorTxResult{
+							TwoFactorSecret: db.TwoFactorSecret{Email: arg.Email, Secret: arg.Secret},
+						}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res enrollTwoFactorResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.Secret)
+				require.True(t, strings.HasPrefix(res.ProvisioningURI, "otpauth://totp/"))
+				require.Len(t, res.RecoveryCodes, utils.RecoveryCodesCount)
+			},
+		},
+		{
+			name: "Already Enabled",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, string(user.Role), time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EnrollTwoFactorTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EnrollTwoFactorTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:      "Unauthorized",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EnrollTwoFactorTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, string(user.Role), time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EnrollTwoFactorTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EnrollTwoFactorTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/users/two_factor/enroll", nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestVerifyTwoFactorAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	secret, _, err := utils.GenerateTOTPKey("blog-go", user.Email)
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := totp.GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	twoFactorSecret := db.TwoFactorSecret{
+		Email:  user.Email,
+		Secret: secret,
+	}
+	// the code used to enable the two-factor authentication cannot be used to log in
+	enableParams := db.EnableTwoFactorSecretParams{
+		Email:        user.Email,
+		LastUsedStep: now.Unix() / 30,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"code": code},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					EnableTwoFactorSecret(gomock.Any(), gomock.Eq(enableParams)).
+					Times(1).
+					Return(db.TwoFactorSecret{Email: user.Email, IsEnabled: true}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code",
+			body: gin.H{"code": invalidTOTPCode(code)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					EnableTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Not Enrolled",
+			body: gin.H{"code": code},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.TwoFactorSecret{}, sql.ErrNoRows)
+				store.EXPECT().
+					EnableTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Already Enabled",
+			body: gin.H{"code": code},
+			buildStubs: func(store *mockdb.MockStore) {
+				enabled := twoFactorSecret
+				enabled.IsEnabled = true
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(enabled, nil)
+				store.EXPECT().
+					EnableTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code Format",
+			body: gin.H{"code": "abcdef"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/two_factor/verify", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user.Email, string(user.Role), time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestLoginTwoFactorAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	secret, _, err := utils.GenerateTOTPKey("blog-go", user.Email)
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := totp.GenerateCode(secret, now)
+	require.NoError(t, err)
+	useStepParams := db.UseTwoFactorStepParams{
+		Email: user.Email,
+		Step:  now.Unix() / 30,
+	}
+
+	twoFactorSecret := db.TwoFactorSecret{
+		Email:     user.Email,
+		Secret:    secret,
+		IsEnabled: true,
+	}
+	challenge := db.TwoFactorChallenge{
+		ID:        uuid.New(),
+		Email:     user.Email,
+		ExpiredAt: time.Now().Add(5 * time.Minute),
+	}
+	incrementParams := db.IncrementTwoFactorChallengeAttemptsParams{
+		ID:          challenge.ID,
+		MaxAttempts: utils.MaxTwoFactorAttempts,
+	}
+	recoveryCode := "abcde-fghij"
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK TOTP Code",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorStep(gomock.Any(), gomock.Eq(useStepParams)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res loginUserResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.NotEmpty(t, res.RefreshToken)
+				require.Equal(t, user.Email, res.User.Email)
+			},
+		},
+		{
+			name: "OK Recovery Code",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				params := db.UseRecoveryCodeParams{
+					Email:      user.Email,
+					HashedCode: utils.HashRecoveryCode(recoveryCode),
+				}
+				store.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.RecoveryCode{Email: user.Email}, nil)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid TOTP Code",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             invalidTOTPCode(code),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Replayed TOTP Code",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				// the step of the code was already used
+				store.EXPECT().
+					UseTwoFactorStep(gomock.Any(), gomock.Eq(useStepParams)).
+					Times(1).
+					Return(db.TwoFactorSecret{}, sql.ErrNoRows)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Used Recovery Code",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RecoveryCode{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Too Many Attempts",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the challenge is not updated once it has no attempts left
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(db.TwoFactorChallenge{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Used Token",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(challenge, nil)
+				store.EXPECT().
+					GetTwoFactorSecret(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorStep(gomock.Any(), gomock.Eq(useStepParams)).
+					Times(1).
+					Return(twoFactorSecret, nil)
+				store.EXPECT().
+					UseTwoFactorChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(db.TwoFactorChallenge{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Token Not Found",
+			body: gin.H{
+				"two_factor_token": challenge.ID,
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Eq(incrementParams)).
+					Times(1).
+					Return(db.TwoFactorChallenge{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Token",
+			body: gin.H{
+				"two_factor_token": "invalid",
+				"code":             code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					IncrementTwoFactorChallengeAttempts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/login/two_factor", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// invalidTOTPCode returns a 6-digit code different from the given one
+func invalidTOTPCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+	return "000000"
+}
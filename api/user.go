@@ -2,6 +2,7 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/utils"
 	"github.com/gin-gonic/gin"
@@ -20,6 +21,7 @@ type createUserRequest struct {
 type userResponse struct {
 	Username          string    `json:"username"`
 	Email             string    `json:"email"`
+	Role              string    `json:"role"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 }
@@ -29,6 +31,7 @@ func newUserResponse(user db.User) userResponse {
 	return userResponse{
 		Email:             user.Email,
 		Username:          user.Username,
+		Role:              string(user.Role),
 		PasswordChangedAt: user.PasswordChangedAt,
 		CreatedAt:         user.CreatedAt,
 	}
@@ -85,7 +88,8 @@ type loginUserResponse struct {
 	User                  userResponse `json:"user"`
 }
 
-// loginUser handles user login
+// loginUser handles user login. If the user has the two-factor authentication enabled,
+// it responds with a two-factor token to be used with a TOTP or recovery code in loginTwoFactor.
 func (server *Server) loginUser(ctx *gin.Context) {
 	var request loginUserRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
@@ -93,9 +97,18 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	if server.isLoginLocked(ctx, request.Email) {
+		return
+	}
+
 	user, err := server.store.GetUser(ctx, request.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
+			// guessing emails is limited by the IP
+			if err := server.failLogin(ctx, ""); err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
 			return
 		}
@@ -105,23 +118,86 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	err = utils.CheckPassword(request.Password, user.HashedPassword)
 	if err != nil {
+		if err := server.failLogin(ctx, user.Email); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, server.config.AccessTokenDuration)
+	server.completeLogin(ctx, user)
+}
+
+// completeLogin responds with the tokens and the session of the authenticated user or,
+// if the user has the two-factor authentication enabled, with a two-factor token.
+// The failed login attempts of the account are reset only when the tokens are issued.
+func (server *Server) completeLogin(ctx *gin.Context, user db.User) {
+	if user.IsDisabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("account is disabled")))
+		return
+	}
+
+	twoFactorEnabled, err := server.isTwoFactorEnabled(ctx, user.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// the tokens are issued only after the second step (loginTwoFactor)
+	if twoFactorEnabled {
+		// every challenge allows more codes to be checked, so none is issued while the account is locked
+		if server.isLoginLocked(ctx, user.Email) {
+			return
+		}
+
+		challenge, err := server.store.CreateTwoFactorChallenge(ctx, db.CreateTwoFactorChallengeParams{
+			ID:    uuid.New(),
+			Email: user.Email,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusAccepted, twoFactorChallengeResponse{
+			TwoFactorRequired:       true,
+			TwoFactorToken:          challenge.ID,
+			TwoFactorTokenExpiresAt: challenge.ExpiredAt,
+		})
+		return
+	}
+
+	err = server.loginLimiter.Succeed(ctx, user.Email)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res, err := server.createUserSession(ctx, user)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	ctx.JSON(http.StatusOK, res)
+}
+
+// createUserSession creates the access and refresh tokens
+// and the session of the user that has just logged in
+func (server *Server) createUserSession(ctx *gin.Context, user db.User) (loginUserResponse, error) {
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, string(user.Role), server.config.AccessTokenDuration)
+	if err != nil {
+		return loginUserResponse{}, err
+	}
+
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Email,
+		string(user.Role),
 		server.config.RefreshTokenDuration,
 	)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return loginUserResponse{}, err
 	}
 
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
@@ -132,10 +208,10 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ClientIp:     ctx.ClientIP(),
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return loginUserResponse{}, err
 	}
 
 	res := loginUserResponse{
@@ -147,5 +223,36 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		User:                  newUserResponse(user),
 	}
 
-	ctx.JSON(http.StatusOK, res)
+	return res, nil
+}
+
+type updateUserRoleRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,role"`
+}
+
+// updateUserRole changes the role of a user, it is available only to admins
+func (server *Server) updateUserRole(ctx *gin.Context) {
+	var request updateUserRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		Email: request.Email,
+		Role:  db.UserRole(request.Role),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	server.passwordChanges.Invalidate(user.Email)
+
+	ctx.JSON(http.StatusOK, newUserResponse(user))
 }
@@ -18,3 +18,10 @@ var isValidTagList validator.Func = func(fieldLevel validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var isValidRole validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if role, ok := fieldLevel.Field().Interface().(string); ok {
+		return utils.IsSupportedRole(role)
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+ALTER TABLE "posts"
+    ADD COLUMN "search_vector" tsvector NOT NULL
+        GENERATED ALWAYS AS (
+                    setweight(to_tsvector('english', coalesce("title", '')), 'A') ||
+                    setweight(to_tsvector('english', coalesce("description", '')), 'B') ||
+                    setweight(to_tsvector('english', coalesce("content", '')), 'C')
+            ) STORED;
+
+CREATE INDEX idx_posts_search_vector ON posts USING GIN ("search_vector");
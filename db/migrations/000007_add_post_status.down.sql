@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_posts_scheduled_publish_at;
+DROP INDEX IF EXISTS idx_posts_status;
+
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS scheduled_posts_publish_at;
+
+ALTER TABLE "posts"
+    DROP COLUMN "publish_at",
+    DROP COLUMN "status";
+
+DROP TYPE IF EXISTS "post_status";
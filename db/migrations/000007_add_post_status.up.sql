@@ -0,0 +1,18 @@
+CREATE TYPE "post_status" AS ENUM (
+    'draft',
+    'scheduled',
+    'published',
+    'archived'
+    );
+
+-- posts created before this migration were published immediately
+ALTER TABLE "posts"
+    ADD COLUMN "status"     post_status NOT NULL DEFAULT 'published',
+    ADD COLUMN "publish_at" TIMESTAMPTZ;
+
+ALTER TABLE "posts"
+    ADD CONSTRAINT scheduled_posts_publish_at
+        CHECK ("status" <> 'scheduled' OR "publish_at" IS NOT NULL);
+
+CREATE INDEX idx_posts_status ON posts ("status");
+CREATE INDEX idx_posts_scheduled_publish_at ON posts ("publish_at") WHERE "status" = 'scheduled';
@@ -0,0 +1,13 @@
+CREATE TABLE "post_revisions"
+(
+    "id"            BIGSERIAL PRIMARY KEY,
+    "post_id"       BIGINT      NOT NULL REFERENCES posts ("id") ON DELETE CASCADE,
+    "revision"      INTEGER     NOT NULL,
+    "title"         VARCHAR     NOT NULL,
+    "description"   VARCHAR     NOT NULL,
+    "content"       TEXT        NOT NULL,
+    "editor_id"     INTEGER     NOT NULL REFERENCES users ("id"),
+    "restored_from" INTEGER,
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT (NOW()),
+    UNIQUE ("post_id", "revision")
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "users" DROP COLUMN "role";
+
+DROP TYPE IF EXISTS "user_role";
\ No newline at end of file
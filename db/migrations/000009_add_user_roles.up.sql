@@ -0,0 +1,14 @@
+CREATE TYPE "user_role" AS ENUM (
+    'reader',
+    'author',
+    'moderator',
+    'admin'
+    );
+
+-- users registered before this migration could already write posts,
+-- the users registered from now on are readers until they are promoted
+ALTER TABLE "users"
+    ADD COLUMN "role" user_role NOT NULL DEFAULT 'author';
+
+ALTER TABLE "users"
+    ALTER COLUMN "role" SET DEFAULT 'reader';
\ No newline at end of file
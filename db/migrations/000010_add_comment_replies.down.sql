@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_comments_parent_id;
+
+ALTER TABLE "comments"
+    DROP COLUMN "is_deleted",
+    DROP COLUMN "parent_id";
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "comments"
+    ADD COLUMN "parent_id"  BIGINT REFERENCES comments ("id"),
+    ADD COLUMN "is_deleted" BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_comments_parent_id ON comments ("parent_id");
\ No newline at end of file
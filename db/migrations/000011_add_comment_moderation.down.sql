@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_comments_status;
+
+ALTER TABLE "comments"
+    DROP COLUMN "moderation_reason",
+    DROP COLUMN "status";
+
+DROP TYPE IF EXISTS "comment_status";
\ No newline at end of file
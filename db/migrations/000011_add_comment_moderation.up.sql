@@ -0,0 +1,13 @@
+CREATE TYPE "comment_status" AS ENUM (
+    'pending',
+    'approved',
+    'rejected',
+    'spam'
+    );
+
+-- comments created before this migration were published without a review
+ALTER TABLE "comments"
+    ADD COLUMN "status"            comment_status NOT NULL DEFAULT 'approved',
+    ADD COLUMN "moderation_reason" VARCHAR        NOT NULL DEFAULT '';
+
+CREATE INDEX idx_comments_status ON comments ("status");
\ No newline at end of file
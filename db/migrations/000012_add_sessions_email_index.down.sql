@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_sessions_email;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX idx_sessions_email ON sessions ("email");
\ No newline at end of file
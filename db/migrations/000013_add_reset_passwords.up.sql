@@ -0,0 +1,13 @@
+CREATE TABLE "reset_passwords"
+(
+    "id"          bigserial PRIMARY KEY,
+    "email"       varchar     NOT NULL,
+    "secret_code" varchar     NOT NULL,
+    "is_used"     bool        NOT NULL DEFAULT false,
+    "created_at"  timestamptz NOT NULL DEFAULT (now()),
+    "expired_at"  timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
+);
+
+ALTER TABLE "reset_passwords" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+CREATE INDEX idx_reset_passwords_email ON reset_passwords ("email");
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_sessions_family_id;
+
+ALTER TABLE "sessions"
+    DROP COLUMN "replaced_by",
+    DROP COLUMN "family_id";
\ No newline at end of file
@@ -0,0 +1,12 @@
+ALTER TABLE "sessions"
+    ADD COLUMN "family_id"   uuid,
+    ADD COLUMN "replaced_by" uuid REFERENCES sessions ("id");
+
+-- every existing session starts its own family
+UPDATE sessions
+SET family_id = id;
+
+ALTER TABLE "sessions"
+    ALTER COLUMN "family_id" SET NOT NULL;
+
+CREATE INDEX idx_sessions_family_id ON sessions ("family_id");
\ No newline at end of file
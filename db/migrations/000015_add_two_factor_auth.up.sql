@@ -0,0 +1,37 @@
+CREATE TABLE "two_factor_secrets"
+(
+    "email"          varchar PRIMARY KEY,
+    "secret"         varchar     NOT NULL,
+    "is_enabled"     bool        NOT NULL DEFAULT false,
+    "enabled_at"     timestamptz,
+    "created_at"     timestamptz NOT NULL DEFAULT (now()),
+    -- the time step of the last accepted TOTP code, the codes of the same or an earlier step are rejected
+    "last_used_step" bigint      NOT NULL DEFAULT 0
+);
+
+CREATE TABLE "recovery_codes"
+(
+    "id"          bigserial PRIMARY KEY,
+    "email"       varchar     NOT NULL,
+    "hashed_code" varchar     NOT NULL,
+    "used_at"     timestamptz,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "two_factor_challenges"
+(
+    "id"         uuid PRIMARY KEY,
+    "email"      varchar     NOT NULL,
+    "attempts"   int         NOT NULL DEFAULT 0,
+    "is_used"    bool        NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '5 minutes')
+);
+
+ALTER TABLE "two_factor_secrets" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "recovery_codes" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "two_factor_challenges" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+CREATE INDEX idx_recovery_codes_email ON recovery_codes ("email");
\ No newline at end of file
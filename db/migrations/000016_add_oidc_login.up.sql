@@ -0,0 +1,22 @@
+CREATE TABLE "user_identities"
+(
+    "id"         bigserial PRIMARY KEY,
+    "email"      varchar     NOT NULL,
+    "issuer"     varchar     NOT NULL,
+    "subject"    varchar     NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    UNIQUE ("issuer", "subject")
+);
+
+CREATE TABLE "oidc_states"
+(
+    "state"         varchar PRIMARY KEY,
+    "nonce"         varchar     NOT NULL,
+    "code_verifier" varchar     NOT NULL,
+    "created_at"    timestamptz NOT NULL DEFAULT (now()),
+    "expired_at"    timestamptz NOT NULL DEFAULT (now() + interval '10 minutes')
+);
+
+ALTER TABLE "user_identities" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+CREATE INDEX idx_user_identities_email ON user_identities ("email");
\ No newline at end of file
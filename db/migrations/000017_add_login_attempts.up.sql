@@ -0,0 +1,7 @@
+CREATE TABLE "login_attempts"
+(
+    "key"             varchar PRIMARY KEY,
+    "failed_attempts" int         NOT NULL DEFAULT 0,
+    "last_failed_at"  timestamptz NOT NULL DEFAULT (now()),
+    "locked_until"    timestamptz
+);
\ No newline at end of file
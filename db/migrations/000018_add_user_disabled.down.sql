@@ -0,0 +1 @@
+ALTER TABLE "users" DROP COLUMN "is_disabled";
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- disabled users cannot log in, see the "user disable" admin command
+ALTER TABLE "users"
+    ADD COLUMN "is_disabled" bool NOT NULL DEFAULT false;
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- tasks written in the same transaction as the domain change and published to asynq by the outbox relay
+CREATE TABLE "outbox"
+(
+    "id"              bigserial PRIMARY KEY,
+    "task_type"       varchar     NOT NULL,
+    "payload"         jsonb       NOT NULL,
+    "queue"           varchar     NOT NULL,
+    "max_retry"       int         NOT NULL,
+    "process_at"      timestamptz NOT NULL DEFAULT (now()),
+    "attempts"        int         NOT NULL DEFAULT 0,
+    "last_error"      varchar     NOT NULL DEFAULT '',
+    "published_at"    timestamptz,
+    "created_at"      timestamptz NOT NULL DEFAULT (now()),
+    -- a message that failed to publish is retried at next_attempt_at, so it does not block the messages after it,
+    -- and after too many attempts it is dead - it is kept with its last error, but not published
+    "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+    "dead_at"         timestamptz
+);
+
+CREATE INDEX idx_outbox_pending ON outbox ("id") WHERE "published_at" IS NULL AND "dead_at" IS NULL;
+CREATE INDEX idx_outbox_published_at ON outbox ("published_at");
\ No newline at end of file
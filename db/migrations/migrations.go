@@ -0,0 +1,42 @@
+// Package migrations embeds the SQL migrations of the database
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// FS contains the migration files, named <version>_<title>.<up|down>.sql
+//
+//go:embed *.sql
+var FS embed.FS
+
+// LatestVersion returns the version of the last migration,
+// which is the version of the database schema expected by the app
+func LatestVersion() (uint, error) {
+	names, err := fs.Glob(FS, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration file name %q: %w", name, err)
+		}
+		if uint(version) > latest {
+			latest = uint(version)
+		}
+	}
+
+	if latest == 0 {
+		return 0, fmt.Errorf("no migrations found")
+	}
+
+	return latest, nil
+}
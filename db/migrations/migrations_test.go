@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	ups, err := fs.Glob(FS, "*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, ups)
+
+	// every migration can be rolled back
+	for _, up := range ups {
+		down := strings.TrimSuffix(up, ".up.sql") + ".down.sql"
+		_, err := fs.Stat(FS, down)
+		require.NoError(t, err, "missing %s", down)
+	}
+
+	// versions are sequential, so the latest version is the number of migrations
+	latest, err := LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint(len(ups)), latest)
+}
+
+func TestStatus_Current(t *testing.T) {
+	require.True(t, Status{Version: 3, Latest: 3}.Current())
+	require.False(t, Status{Version: 2, Latest: 3}.Current())
+	require.False(t, Status{Version: 3, Dirty: true, Latest: 3}.Current())
+}
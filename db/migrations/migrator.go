@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+// Status - the migration status of the database. Version is 0 if no migration has been applied.
+type Status struct {
+	Version uint
+	Dirty   bool
+	Latest  uint
+}
+
+// Current reports whether the database is migrated to the latest migration
+func (status Status) Current() bool {
+	return !status.Dirty && status.Version == status.Latest
+}
+
+// Migrator - runs the embedded migrations against the database.
+// Concurrent runs (e.g. several instances migrating on start) are serialized with a Postgres advisory lock.
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// NewMigrator creates a new Migrator for the database at dbSource (DB_SOURCE)
+func NewMigrator(dbSource string) (*Migrator, error) {
+	source, err := iofs.New(FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", source, dbSource)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create migrate instance: %w", err)
+	}
+	m.Log = logger{}
+
+	return &Migrator{m: m}, nil
+}
+
+// Up applies the next steps migrations, or all the pending migrations if steps is 0
+func (migrator *Migrator) Up(steps int) error {
+	if steps < 0 {
+		return fmt.Errorf("steps must not be negative")
+	}
+
+	if steps == 0 {
+		return ignoreNoChange(migrator.m.Up())
+	}
+
+	return ignoreNoChange(migrator.m.Steps(steps))
+}
+
+// Down rolls back the last steps migrations
+func (migrator *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive")
+	}
+
+	return ignoreNoChange(migrator.m.Steps(-steps))
+}
+
+// Goto migrates the database up or down to the version
+func (migrator *Migrator) Goto(version uint) error {
+	return ignoreNoChange(migrator.m.Migrate(version))
+}
+
+// Status returns the migration status of the database
+func (migrator *Migrator) Status() (Status, error) {
+	latest, err := LatestVersion()
+	if err != nil {
+		return Status{}, err
+	}
+
+	version, dirty, err := migrator.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return Status{}, err
+	}
+
+	status := Status{
+		Version: version,
+		Dirty:   dirty,
+		Latest:  latest,
+	}
+
+	return status, nil
+}
+
+// Close closes the connection to the database
+func (migrator *Migrator) Close() error {
+	sourceErr, dbErr := migrator.m.Close()
+	if sourceErr != nil {
+		return sourceErr
+	}
+
+	return dbErr
+}
+
+// ignoreNoChange treats running a migration that changes nothing as a success
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+
+	return err
+}
+
+// logger logs the migrations applied by golang-migrate with zerolog
+type logger struct{}
+
+func (logger) Printf(format string, v ...interface{}) {
+	log.Info().Msg(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (logger) Verbose() bool {
+	return false
+}
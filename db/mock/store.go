@@ -6,7 +6,9 @@ package mockdb
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/aalug/blog-go/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -78,6 +80,109 @@ func (mr *MockStoreMockRecorder) AddTagsToPost(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToPost", reflect.TypeOf((*MockStore)(nil).AddTagsToPost), arg0, arg1)
 }
 
+// BlockAllSessions mocks base method.
+func (m *MockStore) BlockAllSessions(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockAllSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockAllSessions indicates an expected call of BlockAllSessions.
+func (mr *MockStoreMockRecorder) BlockAllSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockAllSessions", reflect.TypeOf((*MockStore)(nil).BlockAllSessions), arg0, arg1)
+}
+
+// BlockOtherSessions mocks base method.
+func (m *MockStore) BlockOtherSessions(arg0 context.Context, arg1 db.BlockOtherSessionsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockOtherSessions indicates an expected call of BlockOtherSessions.
+func (mr *MockStoreMockRecorder) BlockOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOtherSessions", reflect.TypeOf((*MockStore)(nil).BlockOtherSessions), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 db.BlockSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockSessionFamily mocks base method.
+func (m *MockStore) BlockSessionFamily(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessionFamily", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSessionFamily indicates an expected call of BlockSessionFamily.
+func (mr *MockStoreMockRecorder) BlockSessionFamily(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessionFamily", reflect.TypeOf((*MockStore)(nil).BlockSessionFamily), arg0, arg1)
+}
+
+// CountCommentReplies mocks base method.
+func (m *MockStore) CountCommentReplies(arg0 context.Context, arg1 sql.NullInt64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCommentReplies", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCommentReplies indicates an expected call of CountCommentReplies.
+func (mr *MockStoreMockRecorder) CountCommentReplies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCommentReplies", reflect.TypeOf((*MockStore)(nil).CountCommentReplies), arg0, arg1)
+}
+
+// CountPostRevisions mocks base method.
+func (m *MockStore) CountPostRevisions(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPostRevisions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPostRevisions indicates an expected call of CountPostRevisions.
+func (mr *MockStoreMockRecorder) CountPostRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPostRevisions", reflect.TypeOf((*MockStore)(nil).CountPostRevisions), arg0, arg1)
+}
+
+// CountSearchPosts mocks base method.
+func (m *MockStore) CountSearchPosts(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchPosts", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchPosts indicates an expected call of CountSearchPosts.
+func (mr *MockStoreMockRecorder) CountSearchPosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchPosts", reflect.TypeOf((*MockStore)(nil).CountSearchPosts), arg0, arg1)
+}
+
 // CreateCategory mocks base method.
 func (m *MockStore) CreateCategory(arg0 context.Context, arg1 string) (db.Category, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +213,36 @@ func (mr *MockStoreMockRecorder) CreateComment(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockStore)(nil).CreateComment), arg0, arg1)
 }
 
+// CreateOIDCState mocks base method.
+func (m *MockStore) CreateOIDCState(arg0 context.Context, arg1 db.CreateOIDCStateParams) (db.OIDCState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOIDCState", arg0, arg1)
+	ret0, _ := ret[0].(db.OIDCState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOIDCState indicates an expected call of CreateOIDCState.
+func (mr *MockStoreMockRecorder) CreateOIDCState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCState", reflect.TypeOf((*MockStore)(nil).CreateOIDCState), arg0, arg1)
+}
+
+// CreateOutboxMessage mocks base method.
+func (m *MockStore) CreateOutboxMessage(arg0 context.Context, arg1 db.CreateOutboxMessageParams) (db.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxMessage", arg0, arg1)
+	ret0, _ := ret[0].(db.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxMessage indicates an expected call of CreateOutboxMessage.
+func (mr *MockStoreMockRecorder) CreateOutboxMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxMessage", reflect.TypeOf((*MockStore)(nil).CreateOutboxMessage), arg0, arg1)
+}
+
 // CreatePost mocks base method.
 func (m *MockStore) CreatePost(arg0 context.Context, arg1 db.CreatePostParams) (db.Post, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +258,51 @@ func (mr *MockStoreMockRecorder) CreatePost(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockStore)(nil).CreatePost), arg0, arg1)
 }
 
+// CreatePostRevision mocks base method.
+func (m *MockStore) CreatePostRevision(arg0 context.Context, arg1 db.CreatePostRevisionParams) (db.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePostRevision indicates an expected call of CreatePostRevision.
+func (mr *MockStoreMockRecorder) CreatePostRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostRevision", reflect.TypeOf((*MockStore)(nil).CreatePostRevision), arg0, arg1)
+}
+
+// CreateRecoveryCode mocks base method.
+func (m *MockStore) CreateRecoveryCode(arg0 context.Context, arg1 db.CreateRecoveryCodeParams) (db.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(db.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecoveryCode indicates an expected call of CreateRecoveryCode.
+func (mr *MockStoreMockRecorder) CreateRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockStore)(nil).CreateRecoveryCode), arg0, arg1)
+}
+
+// CreateResetPassword mocks base method.
+func (m *MockStore) CreateResetPassword(arg0 context.Context, arg1 db.CreateResetPasswordParams) (db.ResetPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(db.ResetPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResetPassword indicates an expected call of CreateResetPassword.
+func (mr *MockStoreMockRecorder) CreateResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResetPassword", reflect.TypeOf((*MockStore)(nil).CreateResetPassword), arg0, arg1)
+}
+
 // CreateSession mocks base method.
 func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +333,36 @@ func (mr *MockStoreMockRecorder) CreateTag(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockStore)(nil).CreateTag), arg0, arg1)
 }
 
+// CreateTwoFactorChallenge mocks base method.
+func (m *MockStore) CreateTwoFactorChallenge(arg0 context.Context, arg1 db.CreateTwoFactorChallengeParams) (db.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTwoFactorChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTwoFactorChallenge indicates an expected call of CreateTwoFactorChallenge.
+func (mr *MockStoreMockRecorder) CreateTwoFactorChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactorChallenge", reflect.TypeOf((*MockStore)(nil).CreateTwoFactorChallenge), arg0, arg1)
+}
+
+// CreateTwoFactorSecret mocks base method.
+func (m *MockStore) CreateTwoFactorSecret(arg0 context.Context, arg1 db.CreateTwoFactorSecretParams) (db.TwoFactorSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTwoFactorSecret", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTwoFactorSecret indicates an expected call of CreateTwoFactorSecret.
+func (mr *MockStoreMockRecorder) CreateTwoFactorSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactorSecret", reflect.TypeOf((*MockStore)(nil).CreateTwoFactorSecret), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +378,21 @@ func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
 }
 
+// CreateUserIdentity mocks base method.
+func (m *MockStore) CreateUserIdentity(arg0 context.Context, arg1 db.CreateUserIdentityParams) (db.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *MockStoreMockRecorder) CreateUserIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*MockStore)(nil).CreateUserIdentity), arg0, arg1)
+}
+
 // CreateUserTx mocks base method.
 func (m *MockStore) CreateUserTx(arg0 context.Context, arg1 db.CreateUserTxParams) (db.CreateUserTxResult, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +408,21 @@ func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
 }
 
+// CreateUserWithRole mocks base method.
+func (m *MockStore) CreateUserWithRole(arg0 context.Context, arg1 db.CreateUserWithRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWithRole", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithRole indicates an expected call of CreateUserWithRole.
+func (mr *MockStoreMockRecorder) CreateUserWithRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithRole", reflect.TypeOf((*MockStore)(nil).CreateUserWithRole), arg0, arg1)
+}
+
 // CreateVerifyEmail mocks base method.
 func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +466,35 @@ func (mr *MockStoreMockRecorder) DeleteComment(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockStore)(nil).DeleteComment), arg0, arg1)
 }
 
+// DeleteCommentTx mocks base method.
+func (m *MockStore) DeleteCommentTx(arg0 context.Context, arg1 int64) (db.DeleteCommentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeleteCommentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCommentTx indicates an expected call of DeleteCommentTx.
+func (mr *MockStoreMockRecorder) DeleteCommentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentTx", reflect.TypeOf((*MockStore)(nil).DeleteCommentTx), arg0, arg1)
+}
+
+// DeleteLoginAttempt mocks base method.
+func (m *MockStore) DeleteLoginAttempt(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginAttempt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginAttempt indicates an expected call of DeleteLoginAttempt.
+func (mr *MockStoreMockRecorder) DeleteLoginAttempt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginAttempt", reflect.TypeOf((*MockStore)(nil).DeleteLoginAttempt), arg0, arg1)
+}
+
 // DeletePost mocks base method.
 func (m *MockStore) DeletePost(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -240,6 +509,35 @@ func (mr *MockStoreMockRecorder) DeletePost(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockStore)(nil).DeletePost), arg0, arg1)
 }
 
+// DeletePublishedOutboxMessages mocks base method.
+func (m *MockStore) DeletePublishedOutboxMessages(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublishedOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePublishedOutboxMessages indicates an expected call of DeletePublishedOutboxMessages.
+func (mr *MockStoreMockRecorder) DeletePublishedOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublishedOutboxMessages", reflect.TypeOf((*MockStore)(nil).DeletePublishedOutboxMessages), arg0, arg1)
+}
+
+// DeleteRecoveryCodes mocks base method.
+func (m *MockStore) DeleteRecoveryCodes(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecoveryCodes indicates an expected call of DeleteRecoveryCodes.
+func (mr *MockStoreMockRecorder) DeleteRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCodes", reflect.TypeOf((*MockStore)(nil).DeleteRecoveryCodes), arg0, arg1)
+}
+
 // DeleteTag mocks base method.
 func (m *MockStore) DeleteTag(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -268,6 +566,21 @@ func (mr *MockStoreMockRecorder) DeleteTagsFromPost(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagsFromPost", reflect.TypeOf((*MockStore)(nil).DeleteTagsFromPost), arg0, arg1)
 }
 
+// DeleteUnusedTags mocks base method.
+func (m *MockStore) DeleteUnusedTags(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnusedTags", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnusedTags indicates an expected call of DeleteUnusedTags.
+func (mr *MockStoreMockRecorder) DeleteUnusedTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedTags", reflect.TypeOf((*MockStore)(nil).DeleteUnusedTags), arg0)
+}
+
 // DeleteUser mocks base method.
 func (m *MockStore) DeleteUser(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -282,6 +595,51 @@ func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
 }
 
+// DisableUserTx mocks base method.
+func (m *MockStore) DisableUserTx(arg0 context.Context, arg1 db.DisableUserTxParams) (db.DisableUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DisableUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableUserTx indicates an expected call of DisableUserTx.
+func (mr *MockStoreMockRecorder) DisableUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUserTx", reflect.TypeOf((*MockStore)(nil).DisableUserTx), arg0, arg1)
+}
+
+// EnableTwoFactorSecret mocks base method.
+func (m *MockStore) EnableTwoFactorSecret(arg0 context.Context, arg1 db.EnableTwoFactorSecretParams) (db.TwoFactorSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactorSecret", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableTwoFactorSecret indicates an expected call of EnableTwoFactorSecret.
+func (mr *MockStoreMockRecorder) EnableTwoFactorSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactorSecret", reflect.TypeOf((*MockStore)(nil).EnableTwoFactorSecret), arg0, arg1)
+}
+
+// EnrollTwoFactorTx mocks base method.
+func (m *MockStore) EnrollTwoFactorTx(arg0 context.Context, arg1 db.EnrollTwoFactorTxParams) (db.EnrollTwoFactorTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactorTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EnrollTwoFactorTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTwoFactorTx indicates an expected call of EnrollTwoFactorTx.
+func (mr *MockStoreMockRecorder) EnrollTwoFactorTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactorTx", reflect.TypeOf((*MockStore)(nil).EnrollTwoFactorTx), arg0, arg1)
+}
+
 // ExecTx mocks base method.
 func (m *MockStore) ExecTx(arg0 context.Context, arg1 func(*db.Queries) error) error {
 	m.ctrl.T.Helper()
@@ -296,6 +654,21 @@ func (mr *MockStoreMockRecorder) ExecTx(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecTx", reflect.TypeOf((*MockStore)(nil).ExecTx), arg0, arg1)
 }
 
+// GetCategoryByName mocks base method.
+func (m *MockStore) GetCategoryByName(arg0 context.Context, arg1 string) (db.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByName", arg0, arg1)
+	ret0, _ := ret[0].(db.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByName indicates an expected call of GetCategoryByName.
+func (mr *MockStoreMockRecorder) GetCategoryByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockStore)(nil).GetCategoryByName), arg0, arg1)
+}
+
 // GetComment mocks base method.
 func (m *MockStore) GetComment(arg0 context.Context, arg1 int64) (db.GetCommentRow, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +684,36 @@ func (mr *MockStoreMockRecorder) GetComment(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockStore)(nil).GetComment), arg0, arg1)
 }
 
+// GetCommenterStats mocks base method.
+func (m *MockStore) GetCommenterStats(arg0 context.Context, arg1 db.GetCommenterStatsParams) (db.GetCommenterStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommenterStats", arg0, arg1)
+	ret0, _ := ret[0].(db.GetCommenterStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommenterStats indicates an expected call of GetCommenterStats.
+func (mr *MockStoreMockRecorder) GetCommenterStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommenterStats", reflect.TypeOf((*MockStore)(nil).GetCommenterStats), arg0, arg1)
+}
+
+// GetLoginAttempts mocks base method.
+func (m *MockStore) GetLoginAttempts(arg0 context.Context, arg1 []string) ([]db.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginAttempts", arg0, arg1)
+	ret0, _ := ret[0].([]db.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginAttempts indicates an expected call of GetLoginAttempts.
+func (mr *MockStoreMockRecorder) GetLoginAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginAttempts", reflect.TypeOf((*MockStore)(nil).GetLoginAttempts), arg0, arg1)
+}
+
 // GetMinimalPostData mocks base method.
 func (m *MockStore) GetMinimalPostData(arg0 context.Context, arg1 int64) (db.GetMinimalPostDataRow, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +759,21 @@ func (mr *MockStoreMockRecorder) GetOrCreateTags(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateTags", reflect.TypeOf((*MockStore)(nil).GetOrCreateTags), arg0, arg1)
 }
 
+// GetOutboxMessage mocks base method.
+func (m *MockStore) GetOutboxMessage(arg0 context.Context, arg1 int64) (db.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxMessage", arg0, arg1)
+	ret0, _ := ret[0].(db.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxMessage indicates an expected call of GetOutboxMessage.
+func (mr *MockStoreMockRecorder) GetOutboxMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxMessage", reflect.TypeOf((*MockStore)(nil).GetOutboxMessage), arg0, arg1)
+}
+
 // GetPostByID mocks base method.
 func (m *MockStore) GetPostByID(arg0 context.Context, arg1 int64) (db.GetPostByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +804,36 @@ func (mr *MockStoreMockRecorder) GetPostByTitle(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostByTitle", reflect.TypeOf((*MockStore)(nil).GetPostByTitle), arg0, arg1)
 }
 
+// GetPostForUpdate mocks base method.
+func (m *MockStore) GetPostForUpdate(arg0 context.Context, arg1 int64) (db.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostForUpdate indicates an expected call of GetPostForUpdate.
+func (mr *MockStoreMockRecorder) GetPostForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostForUpdate", reflect.TypeOf((*MockStore)(nil).GetPostForUpdate), arg0, arg1)
+}
+
+// GetPostRevision mocks base method.
+func (m *MockStore) GetPostRevision(arg0 context.Context, arg1 db.GetPostRevisionParams) (db.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostRevision indicates an expected call of GetPostRevision.
+func (mr *MockStoreMockRecorder) GetPostRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevision", reflect.TypeOf((*MockStore)(nil).GetPostRevision), arg0, arg1)
+}
+
 // GetSession mocks base method.
 func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -416,19 +864,138 @@ func (mr *MockStoreMockRecorder) GetTagsOfPost(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsOfPost", reflect.TypeOf((*MockStore)(nil).GetTagsOfPost), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+// GetTwoFactorChallenge mocks base method.
+func (m *MockStore) GetTwoFactorChallenge(arg0 context.Context, arg1 uuid.UUID) (db.TwoFactorChallenge, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "GetTwoFactorChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorChallenge)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// GetTwoFactorChallenge indicates an expected call of GetTwoFactorChallenge.
+func (mr *MockStoreMockRecorder) GetTwoFactorChallenge(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorChallenge", reflect.TypeOf((*MockStore)(nil).GetTwoFactorChallenge), arg0, arg1)
+}
+
+// GetTwoFactorSecret mocks base method.
+func (m *MockStore) GetTwoFactorSecret(arg0 context.Context, arg1 string) (db.TwoFactorSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorSecret", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorSecret indicates an expected call of GetTwoFactorSecret.
+func (mr *MockStoreMockRecorder) GetTwoFactorSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorSecret", reflect.TypeOf((*MockStore)(nil).GetTwoFactorSecret), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserIdentity mocks base method.
+func (m *MockStore) GetUserIdentity(arg0 context.Context, arg1 db.GetUserIdentityParams) (db.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *MockStoreMockRecorder) GetUserIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*MockStore)(nil).GetUserIdentity), arg0, arg1)
+}
+
+// GetUserPasswordChangedAt mocks base method.
+func (m *MockStore) GetUserPasswordChangedAt(arg0 context.Context, arg1 string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPasswordChangedAt", arg0, arg1)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPasswordChangedAt indicates an expected call of GetUserPasswordChangedAt.
+func (mr *MockStoreMockRecorder) GetUserPasswordChangedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordChangedAt", reflect.TypeOf((*MockStore)(nil).GetUserPasswordChangedAt), arg0, arg1)
+}
+
+// GetUserRole mocks base method.
+func (m *MockStore) GetUserRole(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole.
+func (mr *MockStoreMockRecorder) GetUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockStore)(nil).GetUserRole), arg0, arg1)
+}
+
+// IncrementTwoFactorChallengeAttempts mocks base method.
+func (m *MockStore) IncrementTwoFactorChallengeAttempts(arg0 context.Context, arg1 db.IncrementTwoFactorChallengeAttemptsParams) (db.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTwoFactorChallengeAttempts", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementTwoFactorChallengeAttempts indicates an expected call of IncrementTwoFactorChallengeAttempts.
+func (mr *MockStoreMockRecorder) IncrementTwoFactorChallengeAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTwoFactorChallengeAttempts", reflect.TypeOf((*MockStore)(nil).IncrementTwoFactorChallengeAttempts), arg0, arg1)
+}
+
+// InvalidateResetPasswords mocks base method.
+func (m *MockStore) InvalidateResetPasswords(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateResetPasswords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateResetPasswords indicates an expected call of InvalidateResetPasswords.
+func (mr *MockStoreMockRecorder) InvalidateResetPasswords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateResetPasswords", reflect.TypeOf((*MockStore)(nil).InvalidateResetPasswords), arg0, arg1)
+}
+
+// ListActiveSessions mocks base method.
+func (m *MockStore) ListActiveSessions(arg0 context.Context, arg1 string) ([]db.ListActiveSessionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListActiveSessionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessions indicates an expected call of ListActiveSessions.
+func (mr *MockStoreMockRecorder) ListActiveSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessions", reflect.TypeOf((*MockStore)(nil).ListActiveSessions), arg0, arg1)
 }
 
 // ListCategories mocks base method.
@@ -446,6 +1013,36 @@ func (mr *MockStoreMockRecorder) ListCategories(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockStore)(nil).ListCategories), arg0, arg1)
 }
 
+// ListCommentReplies mocks base method.
+func (m *MockStore) ListCommentReplies(arg0 context.Context, arg1 db.ListCommentRepliesParams) ([]db.ListCommentRepliesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentReplies", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCommentRepliesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentReplies indicates an expected call of ListCommentReplies.
+func (mr *MockStoreMockRecorder) ListCommentReplies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentReplies", reflect.TypeOf((*MockStore)(nil).ListCommentReplies), arg0, arg1)
+}
+
+// ListCommentTree mocks base method.
+func (m *MockStore) ListCommentTree(arg0 context.Context, arg1 db.ListCommentTreeParams) ([]db.ListCommentTreeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentTree", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCommentTreeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentTree indicates an expected call of ListCommentTree.
+func (mr *MockStoreMockRecorder) ListCommentTree(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentTree", reflect.TypeOf((*MockStore)(nil).ListCommentTree), arg0, arg1)
+}
+
 // ListCommentsForPost mocks base method.
 func (m *MockStore) ListCommentsForPost(arg0 context.Context, arg1 db.ListCommentsForPostParams) ([]db.ListCommentsForPostRow, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +1058,66 @@ func (mr *MockStoreMockRecorder) ListCommentsForPost(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsForPost", reflect.TypeOf((*MockStore)(nil).ListCommentsForPost), arg0, arg1)
 }
 
+// ListModerationQueue mocks base method.
+func (m *MockStore) ListModerationQueue(arg0 context.Context, arg1 db.ListModerationQueueParams) ([]db.ListModerationQueueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModerationQueue", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListModerationQueueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModerationQueue indicates an expected call of ListModerationQueue.
+func (mr *MockStoreMockRecorder) ListModerationQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModerationQueue", reflect.TypeOf((*MockStore)(nil).ListModerationQueue), arg0, arg1)
+}
+
+// ListOwnPosts mocks base method.
+func (m *MockStore) ListOwnPosts(arg0 context.Context, arg1 db.ListOwnPostsParams) ([]db.ListOwnPostsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnPosts", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListOwnPostsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnPosts indicates an expected call of ListOwnPosts.
+func (mr *MockStoreMockRecorder) ListOwnPosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnPosts", reflect.TypeOf((*MockStore)(nil).ListOwnPosts), arg0, arg1)
+}
+
+// ListPendingOutboxMessages mocks base method.
+func (m *MockStore) ListPendingOutboxMessages(arg0 context.Context, arg1 int32) ([]db.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].([]db.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboxMessages indicates an expected call of ListPendingOutboxMessages.
+func (mr *MockStoreMockRecorder) ListPendingOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboxMessages", reflect.TypeOf((*MockStore)(nil).ListPendingOutboxMessages), arg0, arg1)
+}
+
+// ListPostRevisions mocks base method.
+func (m *MockStore) ListPostRevisions(arg0 context.Context, arg1 db.ListPostRevisionsParams) ([]db.ListPostRevisionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPostRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListPostRevisionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPostRevisions indicates an expected call of ListPostRevisions.
+func (mr *MockStoreMockRecorder) ListPostRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostRevisions", reflect.TypeOf((*MockStore)(nil).ListPostRevisions), arg0, arg1)
+}
+
 // ListPosts mocks base method.
 func (m *MockStore) ListPosts(arg0 context.Context, arg1 db.ListPostsParams) ([]db.ListPostsRow, error) {
 	m.ctrl.T.Helper()
@@ -566,6 +1223,154 @@ func (mr *MockStoreMockRecorder) ListUsersContainingString(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersContainingString", reflect.TypeOf((*MockStore)(nil).ListUsersContainingString), arg0, arg1)
 }
 
+// LockComment mocks base method.
+func (m *MockStore) LockComment(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockComment", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockComment indicates an expected call of LockComment.
+func (mr *MockStoreMockRecorder) LockComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockComment", reflect.TypeOf((*MockStore)(nil).LockComment), arg0, arg1)
+}
+
+// LockLoginAttempt mocks base method.
+func (m *MockStore) LockLoginAttempt(arg0 context.Context, arg1 db.LockLoginAttemptParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLoginAttempt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLoginAttempt indicates an expected call of LockLoginAttempt.
+func (mr *MockStoreMockRecorder) LockLoginAttempt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLoginAttempt", reflect.TypeOf((*MockStore)(nil).LockLoginAttempt), arg0, arg1)
+}
+
+// MarkOutboxMessagePublished mocks base method.
+func (m *MockStore) MarkOutboxMessagePublished(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessagePublished", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessagePublished indicates an expected call of MarkOutboxMessagePublished.
+func (mr *MockStoreMockRecorder) MarkOutboxMessagePublished(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessagePublished", reflect.TypeOf((*MockStore)(nil).MarkOutboxMessagePublished), arg0, arg1)
+}
+
+// MergeCategoriesTx mocks base method.
+func (m *MockStore) MergeCategoriesTx(arg0 context.Context, arg1 db.MergeCategoriesTxParams) (db.MergeCategoriesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategoriesTx", arg0, arg1)
+	ret0, _ := ret[0].(db.MergeCategoriesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategoriesTx indicates an expected call of MergeCategoriesTx.
+func (mr *MockStoreMockRecorder) MergeCategoriesTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategoriesTx", reflect.TypeOf((*MockStore)(nil).MergeCategoriesTx), arg0, arg1)
+}
+
+// MovePostsToCategory mocks base method.
+func (m *MockStore) MovePostsToCategory(arg0 context.Context, arg1 db.MovePostsToCategoryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePostsToCategory", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePostsToCategory indicates an expected call of MovePostsToCategory.
+func (mr *MockStoreMockRecorder) MovePostsToCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePostsToCategory", reflect.TypeOf((*MockStore)(nil).MovePostsToCategory), arg0, arg1)
+}
+
+// OIDCLoginTx mocks base method.
+func (m *MockStore) OIDCLoginTx(arg0 context.Context, arg1 db.OIDCLoginTxParams) (db.OIDCLoginTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCLoginTx", arg0, arg1)
+	ret0, _ := ret[0].(db.OIDCLoginTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCLoginTx indicates an expected call of OIDCLoginTx.
+func (mr *MockStoreMockRecorder) OIDCLoginTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCLoginTx", reflect.TypeOf((*MockStore)(nil).OIDCLoginTx), arg0, arg1)
+}
+
+// PublishScheduledPosts mocks base method.
+func (m *MockStore) PublishScheduledPosts(arg0 context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledPosts", arg0)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledPosts indicates an expected call of PublishScheduledPosts.
+func (mr *MockStoreMockRecorder) PublishScheduledPosts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledPosts", reflect.TypeOf((*MockStore)(nil).PublishScheduledPosts), arg0)
+}
+
+// RecordFailedLoginAttempt mocks base method.
+func (m *MockStore) RecordFailedLoginAttempt(arg0 context.Context, arg1 db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailedLoginAttempt", arg0, arg1)
+	ret0, _ := ret[0].(db.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailedLoginAttempt indicates an expected call of RecordFailedLoginAttempt.
+func (mr *MockStoreMockRecorder) RecordFailedLoginAttempt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailedLoginAttempt", reflect.TypeOf((*MockStore)(nil).RecordFailedLoginAttempt), arg0, arg1)
+}
+
+// RecordOutboxMessageFailure mocks base method.
+func (m *MockStore) RecordOutboxMessageFailure(arg0 context.Context, arg1 db.RecordOutboxMessageFailureParams) (db.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOutboxMessageFailure", arg0, arg1)
+	ret0, _ := ret[0].(db.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordOutboxMessageFailure indicates an expected call of RecordOutboxMessageFailure.
+func (mr *MockStoreMockRecorder) RecordOutboxMessageFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutboxMessageFailure", reflect.TypeOf((*MockStore)(nil).RecordOutboxMessageFailure), arg0, arg1)
+}
+
+// RelayOutboxTx mocks base method.
+func (m *MockStore) RelayOutboxTx(arg0 context.Context, arg1 db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutboxTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RelayOutboxTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutboxTx indicates an expected call of RelayOutboxTx.
+func (mr *MockStoreMockRecorder) RelayOutboxTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxTx", reflect.TypeOf((*MockStore)(nil).RelayOutboxTx), arg0, arg1)
+}
+
 // RemoveAllTagsFromPost mocks base method.
 func (m *MockStore) RemoveAllTagsFromPost(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -594,6 +1399,110 @@ func (mr *MockStoreMockRecorder) RemoveTagsFromPost(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsFromPost", reflect.TypeOf((*MockStore)(nil).RemoveTagsFromPost), arg0, arg1)
 }
 
+// ReplaceSession mocks base method.
+func (m *MockStore) ReplaceSession(arg0 context.Context, arg1 db.ReplaceSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceSession indicates an expected call of ReplaceSession.
+func (mr *MockStoreMockRecorder) ReplaceSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSession", reflect.TypeOf((*MockStore)(nil).ReplaceSession), arg0, arg1)
+}
+
+// ResetPasswordTx mocks base method.
+func (m *MockStore) ResetPasswordTx(arg0 context.Context, arg1 db.ResetPasswordTxParams) (db.ResetPasswordTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ResetPasswordTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordTx indicates an expected call of ResetPasswordTx.
+func (mr *MockStoreMockRecorder) ResetPasswordTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordTx", reflect.TypeOf((*MockStore)(nil).ResetPasswordTx), arg0, arg1)
+}
+
+// RestorePostRevisionTx mocks base method.
+func (m *MockStore) RestorePostRevisionTx(arg0 context.Context, arg1 db.RestorePostRevisionTxParams) (db.RestorePostRevisionTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePostRevisionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RestorePostRevisionTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePostRevisionTx indicates an expected call of RestorePostRevisionTx.
+func (mr *MockStoreMockRecorder) RestorePostRevisionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePostRevisionTx", reflect.TypeOf((*MockStore)(nil).RestorePostRevisionTx), arg0, arg1)
+}
+
+// RotateSessionTx mocks base method.
+func (m *MockStore) RotateSessionTx(arg0 context.Context, arg1 db.RotateSessionTxParams) (db.RotateSessionTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSessionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RotateSessionTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSessionTx indicates an expected call of RotateSessionTx.
+func (mr *MockStoreMockRecorder) RotateSessionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSessionTx", reflect.TypeOf((*MockStore)(nil).RotateSessionTx), arg0, arg1)
+}
+
+// SearchPosts mocks base method.
+func (m *MockStore) SearchPosts(arg0 context.Context, arg1 db.SearchPostsParams) ([]db.SearchPostsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchPostsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockStoreMockRecorder) SearchPosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockStore)(nil).SearchPosts), arg0, arg1)
+}
+
+// SetUserDisabled mocks base method.
+func (m *MockStore) SetUserDisabled(arg0 context.Context, arg1 db.SetUserDisabledParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserDisabled", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserDisabled indicates an expected call of SetUserDisabled.
+func (mr *MockStoreMockRecorder) SetUserDisabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserDisabled", reflect.TypeOf((*MockStore)(nil).SetUserDisabled), arg0, arg1)
+}
+
+// SoftDeleteComment mocks base method.
+func (m *MockStore) SoftDeleteComment(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteComment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteComment indicates an expected call of SoftDeleteComment.
+func (mr *MockStoreMockRecorder) SoftDeleteComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteComment", reflect.TypeOf((*MockStore)(nil).SoftDeleteComment), arg0, arg1)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockStore) UpdateCategory(arg0 context.Context, arg1 db.UpdateCategoryParams) (db.Category, error) {
 	m.ctrl.T.Helper()
@@ -624,6 +1533,21 @@ func (mr *MockStoreMockRecorder) UpdateComment(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockStore)(nil).UpdateComment), arg0, arg1)
 }
 
+// UpdateCommentsStatus mocks base method.
+func (m *MockStore) UpdateCommentsStatus(arg0 context.Context, arg1 db.UpdateCommentsStatusParams) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentsStatus", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCommentsStatus indicates an expected call of UpdateCommentsStatus.
+func (mr *MockStoreMockRecorder) UpdateCommentsStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentsStatus", reflect.TypeOf((*MockStore)(nil).UpdateCommentsStatus), arg0, arg1)
+}
+
 // UpdatePost mocks base method.
 func (m *MockStore) UpdatePost(arg0 context.Context, arg1 db.UpdatePostParams) (db.Post, error) {
 	m.ctrl.T.Helper()
@@ -639,6 +1563,36 @@ func (mr *MockStoreMockRecorder) UpdatePost(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockStore)(nil).UpdatePost), arg0, arg1)
 }
 
+// UpdatePostStatus mocks base method.
+func (m *MockStore) UpdatePostStatus(arg0 context.Context, arg1 db.UpdatePostStatusParams) (db.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePostStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePostStatus indicates an expected call of UpdatePostStatus.
+func (mr *MockStoreMockRecorder) UpdatePostStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePostStatus", reflect.TypeOf((*MockStore)(nil).UpdatePostStatus), arg0, arg1)
+}
+
+// UpdatePostTx mocks base method.
+func (m *MockStore) UpdatePostTx(arg0 context.Context, arg1 db.UpdatePostTxParams) (db.UpdatePostTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePostTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdatePostTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePostTx indicates an expected call of UpdatePostTx.
+func (mr *MockStoreMockRecorder) UpdatePostTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePostTx", reflect.TypeOf((*MockStore)(nil).UpdatePostTx), arg0, arg1)
+}
+
 // UpdateTag mocks base method.
 func (m *MockStore) UpdateTag(arg0 context.Context, arg1 db.UpdateTagParams) (db.Tag, error) {
 	m.ctrl.T.Helper()
@@ -669,6 +1623,21 @@ func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
 }
 
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(arg0 context.Context, arg1 db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), arg0, arg1)
+}
+
 // UpdateVerifyEmail mocks base method.
 func (m *MockStore) UpdateVerifyEmail(arg0 context.Context, arg1 db.UpdateVerifyEmailParams) (db.VerifyEmail, error) {
 	m.ctrl.T.Helper()
@@ -684,6 +1653,81 @@ func (mr *MockStoreMockRecorder) UpdateVerifyEmail(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifyEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerifyEmail), arg0, arg1)
 }
 
+// UseOIDCState mocks base method.
+func (m *MockStore) UseOIDCState(arg0 context.Context, arg1 string) (db.OIDCState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseOIDCState", arg0, arg1)
+	ret0, _ := ret[0].(db.OIDCState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseOIDCState indicates an expected call of UseOIDCState.
+func (mr *MockStoreMockRecorder) UseOIDCState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseOIDCState", reflect.TypeOf((*MockStore)(nil).UseOIDCState), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockStore) UseRecoveryCode(arg0 context.Context, arg1 db.UseRecoveryCodeParams) (db.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(db.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockStoreMockRecorder) UseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockStore)(nil).UseRecoveryCode), arg0, arg1)
+}
+
+// UseResetPassword mocks base method.
+func (m *MockStore) UseResetPassword(arg0 context.Context, arg1 db.UseResetPasswordParams) (db.ResetPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(db.ResetPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseResetPassword indicates an expected call of UseResetPassword.
+func (mr *MockStoreMockRecorder) UseResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseResetPassword", reflect.TypeOf((*MockStore)(nil).UseResetPassword), arg0, arg1)
+}
+
+// UseTwoFactorChallenge mocks base method.
+func (m *MockStore) UseTwoFactorChallenge(arg0 context.Context, arg1 uuid.UUID) (db.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTwoFactorChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTwoFactorChallenge indicates an expected call of UseTwoFactorChallenge.
+func (mr *MockStoreMockRecorder) UseTwoFactorChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTwoFactorChallenge", reflect.TypeOf((*MockStore)(nil).UseTwoFactorChallenge), arg0, arg1)
+}
+
+// UseTwoFactorStep mocks base method.
+func (m *MockStore) UseTwoFactorStep(arg0 context.Context, arg1 db.UseTwoFactorStepParams) (db.TwoFactorSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTwoFactorStep", arg0, arg1)
+	ret0, _ := ret[0].(db.TwoFactorSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTwoFactorStep indicates an expected call of UseTwoFactorStep.
+func (mr *MockStoreMockRecorder) UseTwoFactorStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTwoFactorStep", reflect.TypeOf((*MockStore)(nil).UseTwoFactorStep), arg0, arg1)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(arg0 context.Context, arg1 db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
@@ -34,3 +34,9 @@ SET name = $2
 WHERE name = $1
 RETURNING *;
 
+-- name: GetCategoryByName :one
+SELECT *
+FROM categories
+WHERE name = $1
+LIMIT 1;
+
@@ -1,21 +1,24 @@
 -- name: CreateComment :one
 INSERT INTO "comments"
-    (content, user_id, post_id)
-VALUES ($1, $2, $3)
+    (content, user_id, post_id, parent_id, status, moderation_reason)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: ListCommentsForPost :many
-SELECT c.id, c.content, c.user_id, u.username, c.created_at
+SELECT c.id, c.content, c.user_id, u.username, c.parent_id, c.is_deleted, c.created_at
 FROM "comments" c
          JOIN "users" u ON c.user_id = u.id
 WHERE c.post_id = $1
+  AND c.status = 'approved'
 ORDER BY c.created_at DESC
 LIMIT $2 OFFSET $3;
 
 -- name: UpdateComment :one
 UPDATE "comments"
-SET content = $2
-WHERE id = $1
+SET content           = sqlc.arg('content'),
+    status            = COALESCE(sqlc.narg('status'), status),
+    moderation_reason = COALESCE(sqlc.narg('moderation_reason'), moderation_reason)
+WHERE id = sqlc.arg('id')
 RETURNING *;
 
 -- name: DeleteComment :exec
@@ -24,6 +27,104 @@ FROM "comments"
 WHERE id = $1;
 
 -- name: GetComment :one
-SELECT id, user_id
+SELECT id, user_id, post_id, parent_id, is_deleted, status
+FROM "comments"
+WHERE id = $1;
+
+-- name: LockComment :one
+SELECT id
 FROM "comments"
+WHERE id = $1
+    FOR UPDATE;
+
+-- name: SoftDeleteComment :exec
+UPDATE "comments"
+SET content    = '[deleted]',
+    is_deleted = true
 WHERE id = $1;
+
+-- name: CountCommentReplies :one
+SELECT COUNT(*)
+FROM "comments"
+WHERE parent_id = $1;
+
+-- name: ListCommentReplies :many
+SELECT c.id,
+       c.content,
+       c.user_id,
+       u.username,
+       c.post_id,
+       c.parent_id,
+       c.is_deleted,
+       c.created_at,
+       (SELECT COUNT(*)
+        FROM "comments" r
+        WHERE r.parent_id = c.id
+          AND r.status = 'approved') AS reply_count
+FROM "comments" c
+         JOIN "users" u ON c.user_id = u.id
+WHERE c.parent_id = $1
+  AND c.status = 'approved'
+ORDER BY c.created_at
+LIMIT $2 OFFSET $3;
+
+-- name: ListCommentTree :many
+WITH RECURSIVE tree AS (
+    (SELECT c.*, 0 AS depth
+     FROM "comments" c
+     WHERE c.post_id = $1
+       AND c.parent_id IS NULL
+       AND c.status = 'approved'
+     ORDER BY c.created_at DESC
+     LIMIT $2 OFFSET $3)
+    UNION ALL
+    SELECT c.*, t.depth + 1
+    FROM "comments" c
+             JOIN tree t ON c.parent_id = t.id
+    WHERE c.status = 'approved'
+)
+SELECT t.id,
+       t.content,
+       t.user_id,
+       u.username,
+       t.parent_id,
+       t.is_deleted,
+       t.created_at,
+       t.depth,
+       (SELECT COUNT(*)
+        FROM "comments" r
+        WHERE r.parent_id = t.id
+          AND r.status = 'approved') AS reply_count
+FROM tree t
+         JOIN "users" u ON t.user_id = u.id
+ORDER BY t.depth, CASE WHEN t.depth = 0 THEN t.created_at END DESC, t.created_at;
+
+
+-- name: GetCommenterStats :one
+SELECT COUNT(*) FILTER (WHERE status = 'approved')                                 AS approved_comments,
+       COUNT(*) FILTER (WHERE created_at > sqlc.arg('since')::timestamptz) AS recent_comments
+FROM "comments"
+WHERE user_id = sqlc.arg('user_id');
+
+-- name: ListModerationQueue :many
+SELECT c.id,
+       c.content,
+       c.user_id,
+       u.username,
+       c.post_id,
+       c.parent_id,
+       c.status,
+       c.moderation_reason,
+       c.created_at
+FROM "comments" c
+         JOIN "users" u ON c.user_id = u.id
+WHERE c.status = $1
+ORDER BY c.created_at
+LIMIT $2 OFFSET $3;
+
+-- name: UpdateCommentsStatus :many
+UPDATE "comments"
+SET status = sqlc.arg('status')
+WHERE id = ANY (sqlc.arg('ids')::bigint[])
+  AND status IN ('pending', 'spam', 'rejected')
+RETURNING id;
\ No newline at end of file
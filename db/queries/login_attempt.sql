@@ -0,0 +1,26 @@
+-- name: GetLoginAttempts :many
+SELECT *
+FROM login_attempts
+WHERE key = ANY (@keys::varchar[]);
+
+-- name: RecordFailedLoginAttempt :one
+INSERT INTO login_attempts
+    (key, failed_attempts, last_failed_at)
+VALUES (@key, 1, @failed_at)
+ON CONFLICT (key) DO UPDATE
+    SET failed_attempts = CASE
+                              WHEN login_attempts.last_failed_at < @reset_before::timestamptz THEN 1
+                              ELSE login_attempts.failed_attempts + 1
+        END,
+        last_failed_at  = @failed_at
+RETURNING *;
+
+-- name: LockLoginAttempt :exec
+UPDATE login_attempts
+SET locked_until = $2
+WHERE key = $1;
+
+-- name: DeleteLoginAttempt :exec
+DELETE
+FROM login_attempts
+WHERE key = $1;
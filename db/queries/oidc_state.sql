@@ -0,0 +1,12 @@
+-- name: CreateOIDCState :one
+INSERT INTO oidc_states
+    (state, nonce, code_verifier)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UseOIDCState :one
+DELETE
+FROM oidc_states
+WHERE state = $1
+  AND expired_at > now()
+RETURNING *;
@@ -0,0 +1,46 @@
+-- name: CreateOutboxMessage :one
+INSERT INTO outbox (task_type,
+                    payload,
+                    queue,
+                    max_retry,
+                    process_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetOutboxMessage :one
+SELECT *
+FROM outbox
+WHERE id = $1;
+
+-- name: ListPendingOutboxMessages :many
+-- locks the pending messages due to be published until the end of the transaction,
+-- so concurrent relays publish different messages
+SELECT *
+FROM outbox
+WHERE published_at IS NULL
+  AND dead_at IS NULL
+  AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $1 FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = now(),
+    attempts     = attempts + 1
+WHERE id = $1;
+
+-- name: RecordOutboxMessageFailure :one
+-- retries the message after the delay or, if it has failed max_attempts times, marks it as dead
+UPDATE outbox
+SET attempts        = attempts + 1,
+    last_error      = @last_error,
+    next_attempt_at = now() + make_interval(secs => @retry_delay_seconds::int),
+    dead_at         = CASE WHEN attempts + 1 >= @max_attempts::int THEN now() END
+WHERE id = @id
+RETURNING *;
+
+-- name: DeletePublishedOutboxMessages :execrows
+-- deletes the messages published before the time
+DELETE
+FROM outbox
+WHERE published_at < sqlc.arg(published_before)::timestamptz;
\ No newline at end of file
@@ -1,13 +1,14 @@
 -- name: CreatePost :one
 INSERT INTO posts
-    (title, description, content, author_id, category_id, image)
-VALUES ($1, $2, $3, $4, $5, $6)
+    (title, description, content, author_id, category_id, image, status, publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
 -- name: GetMinimalPostData :one
 SELECT
     id,
-    author_id
+    author_id,
+    status
 FROM posts
 WHERE id = $1;
 
@@ -17,8 +18,11 @@ SELECT p.id,
        p.description,
        p.content,
        u.username AS author_username,
+       u.email    AS author_email,
        c.name     AS category_name,
        p.image,
+       p.status,
+       p.publish_at,
        p.created_at
 FROM posts p
          JOIN users u ON p.author_id = u.id
@@ -31,8 +35,11 @@ SELECT p.id,
        p.description,
        p.content,
        u.username AS author_username,
+       u.email    AS author_email,
        c.name     AS category_name,
        p.image,
+       p.status,
+       p.publish_at,
        p.created_at
 FROM posts p
          JOIN users u ON p.author_id = u.id
@@ -40,7 +47,8 @@ FROM posts p
 WHERE p.title = $1;
 
 -- name: ListPosts :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -49,11 +57,13 @@ SELECT p.title,
 FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
+WHERE p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $1 OFFSET $2;
 
 -- name: ListPostsByCategory :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -63,11 +73,13 @@ FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
 WHERE c.id = $1
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $2 OFFSET $3;
 
 -- name: ListPostsByAuthor :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -77,11 +89,13 @@ FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
 WHERE p.author_id = $1
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $2 OFFSET $3;
 
 -- name: ListPostsByTags :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -93,6 +107,7 @@ FROM posts p
          JOIN post_tags pt ON p.id = pt.post_id
          JOIN tags t ON pt.tag_id = t.id
 WHERE t.id = ANY (@tag_ids::int[])
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $1 OFFSET $2;
 
@@ -111,3 +126,78 @@ RETURNING *;
 DELETE
 FROM posts
 WHERE id = $1;
+
+-- name: SearchPosts :many
+SELECT p.id,
+       p.title,
+       p.description,
+       u.username                                       AS author_username,
+       c.name                                           AS category_name,
+       p.image,
+       p.created_at,
+       ts_rank(p.search_vector,
+               websearch_to_tsquery('english', @query::text))::real AS rank,
+       -- the title and the content are HTML-escaped before the highlighting,
+       -- so the only HTML in the highlighted title and the snippet are the <mark> tags
+       ts_headline('english',
+                   replace(replace(replace(replace(p.title,
+                       '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+                   websearch_to_tsquery('english', @query::text),
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS highlighted_title,
+       ts_headline('english',
+                   replace(replace(replace(replace(p.content,
+                       '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+                   websearch_to_tsquery('english', @query::text),
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10')::text AS snippet
+FROM posts p
+         JOIN users u ON p.author_id = u.id
+         JOIN categories c ON p.category_id = c.id
+WHERE p.search_vector @@ websearch_to_tsquery('english', @query::text)
+  AND p.status = 'published'
+ORDER BY rank DESC, p.created_at DESC
+LIMIT @page_limit OFFSET @page_offset;
+
+-- name: CountSearchPosts :one
+SELECT count(*)
+FROM posts
+WHERE search_vector @@ websearch_to_tsquery('english', @query::text)
+  AND status = 'published';
+
+-- name: ListOwnPosts :many
+SELECT p.id,
+       p.title,
+       p.description,
+       c.name AS category_name,
+       p.image,
+       p.status,
+       p.publish_at,
+       p.created_at,
+       p.updated_at
+FROM posts p
+         JOIN categories c ON p.category_id = c.id
+WHERE p.author_id = @author_id
+  AND (sqlc.narg('status')::post_status IS NULL OR p.status = sqlc.narg('status'))
+ORDER BY p.updated_at DESC
+LIMIT @page_limit OFFSET @page_offset;
+
+-- name: UpdatePostStatus :one
+UPDATE posts
+SET status     = $2,
+    publish_at = $3,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: PublishScheduledPosts :many
+UPDATE posts
+SET status     = 'published',
+    updated_at = now()
+WHERE status = 'scheduled'
+  AND publish_at <= now()
+RETURNING id;
+
+-- name: MovePostsToCategory :execrows
+-- moves all posts of a category to another category, returns the number of moved posts
+UPDATE posts
+SET category_id = sqlc.arg(to_category_id)
+WHERE category_id = sqlc.arg(from_category_id);
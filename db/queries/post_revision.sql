@@ -0,0 +1,44 @@
+-- name: CreatePostRevision :one
+INSERT INTO "post_revisions"
+    (post_id, revision, title, description, content, editor_id, restored_from)
+SELECT @post_id,
+       COALESCE(MAX(revision), 0) + 1,
+       @title,
+       @description,
+       @content,
+       @editor_id,
+       sqlc.narg('restored_from')
+FROM "post_revisions"
+WHERE post_id = @post_id
+RETURNING *;
+
+-- name: ListPostRevisions :many
+SELECT r.id,
+       r.revision,
+       r.title,
+       u.username AS editor_username,
+       r.restored_from,
+       r.created_at
+FROM "post_revisions" r
+         JOIN "users" u ON r.editor_id = u.id
+WHERE r.post_id = $1
+ORDER BY r.revision DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetPostRevision :one
+SELECT *
+FROM "post_revisions"
+WHERE post_id = $1
+  AND revision = $2;
+
+-- name: CountPostRevisions :one
+SELECT COUNT(*)
+FROM "post_revisions"
+WHERE post_id = $1;
+
+-- name: GetPostForUpdate :one
+SELECT *
+FROM posts
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
\ No newline at end of file
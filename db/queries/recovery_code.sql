@@ -0,0 +1,18 @@
+-- name: CreateRecoveryCode :one
+INSERT INTO recovery_codes
+    (email, hashed_code)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM recovery_codes
+WHERE email = $1;
+
+-- name: UseRecoveryCode :one
+UPDATE recovery_codes
+SET used_at = now()
+WHERE email = $1
+  AND hashed_code = $2
+  AND used_at IS NULL
+RETURNING *;
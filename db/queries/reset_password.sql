@@ -0,0 +1,20 @@
+-- name: CreateResetPassword :one
+INSERT INTO reset_passwords
+    (email, secret_code)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UseResetPassword :one
+UPDATE reset_passwords
+SET is_used = TRUE
+WHERE email = $1
+  AND secret_code = $2
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING *;
+
+-- name: InvalidateResetPasswords :exec
+UPDATE reset_passwords
+SET is_used = TRUE
+WHERE email = $1
+  AND is_used = FALSE;
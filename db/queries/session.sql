@@ -5,12 +5,66 @@ INSERT INTO sessions (id,
                       user_agent,
                       client_ip,
                       is_blocked,
-                      expires_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+                      expires_at,
+                      family_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
 -- name: GetSession :one
 SELECT *
 FROM sessions
 WHERE id = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: ListActiveSessions :many
+-- lists the current (not rotated) session of every active family,
+-- created_at is the time of the login that started the family
+SELECT s.id,
+       s.user_agent,
+       s.client_ip,
+       s.expires_at,
+       f.created_at
+FROM sessions s
+         JOIN sessions f ON f.id = s.family_id
+WHERE s.email = $1
+  AND s.is_blocked = false
+  AND s.replaced_by IS NULL
+  AND s.expires_at > now()
+ORDER BY f.created_at DESC;
+
+-- name: BlockSession :execrows
+-- blocks the whole family of the session
+UPDATE sessions
+SET is_blocked = true
+WHERE sessions.email = sqlc.arg(email)
+  AND sessions.family_id = (SELECT s.family_id FROM sessions s WHERE s.id = sqlc.arg(id));
+
+-- name: BlockSessionFamily :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1
+  AND is_blocked = false;
+
+-- name: BlockOtherSessions :execrows
+-- blocks all session families of the user except the current one,
+-- returns the number of blocked sessions
+UPDATE sessions
+SET is_blocked = true
+WHERE email = sqlc.arg(email)
+  AND family_id <> sqlc.arg(current_family_id)
+  AND is_blocked = false;
+
+-- name: BlockAllSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE email = $1
+  AND is_blocked = false;
+
+-- name: ReplaceSession :one
+-- marks the session as rotated, only if it is current and not blocked
+UPDATE sessions
+SET replaced_by = sqlc.arg(replaced_by)::uuid
+WHERE id = sqlc.arg(id)
+  AND replaced_by IS NULL
+  AND is_blocked = false
+RETURNING *;
\ No newline at end of file
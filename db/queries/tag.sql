@@ -38,4 +38,11 @@ FROM tags
 WHERE name IN (SELECT name FROM input_tags)
 UNION ALL
 SELECT id
-FROM created_tags;
\ No newline at end of file
+FROM created_tags;
+
+-- name: DeleteUnusedTags :many
+-- deletes the tags that are not added to any post, returns their names
+DELETE
+FROM tags
+WHERE NOT EXISTS (SELECT 1 FROM post_tags WHERE post_tags.tag_id = tags.id)
+RETURNING name;
\ No newline at end of file
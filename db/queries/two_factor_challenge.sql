@@ -0,0 +1,29 @@
+-- name: CreateTwoFactorChallenge :one
+INSERT INTO two_factor_challenges
+    (id, email)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetTwoFactorChallenge :one
+SELECT *
+FROM two_factor_challenges
+WHERE id = $1
+LIMIT 1;
+
+-- name: IncrementTwoFactorChallengeAttempts :one
+-- counts an attempt to use the challenge, unless it is used, expired or has no attempts left
+UPDATE two_factor_challenges
+SET attempts = attempts + 1
+WHERE id = @id
+  AND attempts < @max_attempts::int
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING *;
+
+-- name: UseTwoFactorChallenge :one
+UPDATE two_factor_challenges
+SET is_used = TRUE
+WHERE id = $1
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING *;
@@ -0,0 +1,35 @@
+-- name: CreateTwoFactorSecret :one
+INSERT INTO two_factor_secrets
+    (email, secret)
+VALUES ($1, $2)
+ON CONFLICT (email) DO UPDATE
+    SET secret     = EXCLUDED.secret,
+        created_at = now()
+WHERE two_factor_secrets.is_enabled = FALSE
+RETURNING *;
+
+-- name: GetTwoFactorSecret :one
+SELECT *
+FROM two_factor_secrets
+WHERE email = $1
+LIMIT 1;
+
+-- name: EnableTwoFactorSecret :one
+-- enables the secret after the code of the time step was accepted
+UPDATE two_factor_secrets
+SET is_enabled     = TRUE,
+    enabled_at     = now(),
+    last_used_step = @last_used_step
+WHERE email = @email
+  AND is_enabled = FALSE
+RETURNING *;
+
+-- name: UseTwoFactorStep :one
+-- records the time step of the accepted TOTP code, so the codes of the same
+-- or an earlier step are rejected and an accepted code cannot be replayed
+UPDATE two_factor_secrets
+SET last_used_step = @step
+WHERE email = @email
+  AND is_enabled = TRUE
+  AND last_used_step < @step
+RETURNING *;
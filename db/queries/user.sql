@@ -28,4 +28,35 @@ SET hashed_password     = COALESCE(sqlc.narg('hashed_password'), hashed_password
     username            = COALESCE(sqlc.narg('username'), username),
     is_email_verified   = COALESCE(sqlc.narg('is_email_verified'), is_email_verified)
 WHERE email = sqlc.arg('email')
+RETURNING *;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE email = $1
+RETURNING *;
+
+-- name: GetUserPasswordChangedAt :one
+SELECT password_changed_at
+FROM users
+WHERE email = $1
+LIMIT 1;
+
+-- name: GetUserRole :one
+SELECT role::text
+FROM users
+WHERE email = $1
+LIMIT 1;
+
+-- name: CreateUserWithRole :one
+-- creates a user with the role and the email verification status set by an admin
+INSERT INTO users
+    (username, email, hashed_password, role, is_email_verified)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: SetUserDisabled :one
+UPDATE users
+SET is_disabled = $2
+WHERE email = $1
 RETURNING *;
\ No newline at end of file
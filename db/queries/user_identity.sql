@@ -0,0 +1,12 @@
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities
+    (email, issuer, subject)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetUserIdentity :one
+SELECT *
+FROM user_identities
+WHERE issuer = $1
+  AND subject = $2
+LIMIT 1;
@@ -34,6 +34,20 @@ func (q *Queries) DeleteCategory(ctx context.Context, name string) error {
 	return err
 }
 
+const getCategoryByName = `-- name: GetCategoryByName :one
+SELECT id, name, created_at
+FROM categories
+WHERE name = $1
+LIMIT 1
+`
+
+func (q *Queries) GetCategoryByName(ctx context.Context, name string) (Category, error) {
+	row := q.db.QueryRowContext(ctx, getCategoryByName, name)
+	var i Category
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
+
 const getOrCreateCategory = `-- name: GetOrCreateCategory :one
 WITH new_category AS (
     INSERT INTO categories (name)
@@ -7,24 +7,50 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const countCommentReplies = `-- name: CountCommentReplies :one
+SELECT COUNT(*)
+FROM "comments"
+WHERE parent_id = $1
+`
+
+func (q *Queries) CountCommentReplies(ctx context.Context, parentID sql.NullInt64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCommentReplies, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createComment = `-- name: CreateComment :one
 INSERT INTO "comments"
-    (content, user_id, post_id)
-VALUES ($1, $2, $3)
-RETURNING id, content, user_id, post_id, created_at
+    (content, user_id, post_id, parent_id, status, moderation_reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, content, user_id, post_id, created_at, parent_id, is_deleted, status, moderation_reason
 `
 
 type CreateCommentParams struct {
-	Content string `json:"content"`
-	UserID  int32  `json:"user_id"`
-	PostID  int32  `json:"post_id"`
+	Content          string        `json:"content"`
+	UserID           int32         `json:"user_id"`
+	PostID           int32         `json:"post_id"`
+	ParentID         sql.NullInt64 `json:"parent_id"`
+	Status           CommentStatus `json:"status"`
+	ModerationReason string        `json:"moderation_reason"`
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error) {
-	row := q.db.QueryRowContext(ctx, createComment, arg.Content, arg.UserID, arg.PostID)
+	row := q.db.QueryRowContext(ctx, createComment,
+		arg.Content,
+		arg.UserID,
+		arg.PostID,
+		arg.ParentID,
+		arg.Status,
+		arg.ModerationReason,
+	)
 	var i Comment
 	err := row.Scan(
 		&i.ID,
@@ -32,6 +58,10 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		&i.UserID,
 		&i.PostID,
 		&i.CreatedAt,
+		&i.ParentID,
+		&i.IsDeleted,
+		&i.Status,
+		&i.ModerationReason,
 	)
 	return i, err
 }
@@ -48,28 +78,219 @@ func (q *Queries) DeleteComment(ctx context.Context, id int64) error {
 }
 
 const getComment = `-- name: GetComment :one
-SELECT id, user_id
+SELECT id, user_id, post_id, parent_id, is_deleted, status
 FROM "comments"
 WHERE id = $1
 `
 
 type GetCommentRow struct {
-	ID     int64 `json:"id"`
-	UserID int32 `json:"user_id"`
+	ID        int64         `json:"id"`
+	UserID    int32         `json:"user_id"`
+	PostID    int32         `json:"post_id"`
+	ParentID  sql.NullInt64 `json:"parent_id"`
+	IsDeleted bool          `json:"is_deleted"`
+	Status    CommentStatus `json:"status"`
 }
 
 func (q *Queries) GetComment(ctx context.Context, id int64) (GetCommentRow, error) {
 	row := q.db.QueryRowContext(ctx, getComment, id)
 	var i GetCommentRow
-	err := row.Scan(&i.ID, &i.UserID)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PostID,
+		&i.ParentID,
+		&i.IsDeleted,
+		&i.Status,
+	)
 	return i, err
 }
 
+const getCommenterStats = `-- name: GetCommenterStats :one
+SELECT COUNT(*) FILTER (WHERE status = 'approved')                                 AS approved_comments,
+       COUNT(*) FILTER (WHERE created_at > $1::timestamptz) AS recent_comments
+FROM "comments"
+WHERE user_id = $2
+`
+
+type GetCommenterStatsParams struct {
+	Since  time.Time `json:"since"`
+	UserID int32     `json:"user_id"`
+}
+
+type GetCommenterStatsRow struct {
+	ApprovedComments int64 `json:"approved_comments"`
+	RecentComments   int64 `json:"recent_comments"`
+}
+
+func (q *Queries) GetCommenterStats(ctx context.Context, arg GetCommenterStatsParams) (GetCommenterStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getCommenterStats, arg.Since, arg.UserID)
+	var i GetCommenterStatsRow
+	err := row.Scan(&i.ApprovedComments, &i.RecentComments)
+	return i, err
+}
+
+const listCommentReplies = `-- name: ListCommentReplies :many
+SELECT c.id,
+       c.content,
+       c.user_id,
+       u.username,
+       c.post_id,
+       c.parent_id,
+       c.is_deleted,
+       c.created_at,
+       (SELECT COUNT(*)
+        FROM "comments" r
+        WHERE r.parent_id = c.id
+          AND r.status = 'approved') AS reply_count
+FROM "comments" c
+         JOIN "users" u ON c.user_id = u.id
+WHERE c.parent_id = $1
+  AND c.status = 'approved'
+ORDER BY c.created_at
+LIMIT $2 OFFSET $3
+`
+
+type ListCommentRepliesParams struct {
+	ParentID sql.NullInt64 `json:"parent_id"`
+	Limit    int32         `json:"limit"`
+	Offset   int32         `json:"offset"`
+}
+
+type ListCommentRepliesRow struct {
+	ID         int64         `json:"id"`
+	Content    string        `json:"content"`
+	UserID     int32         `json:"user_id"`
+	Username   string        `json:"username"`
+	PostID     int32         `json:"post_id"`
+	ParentID   sql.NullInt64 `json:"parent_id"`
+	IsDeleted  bool          `json:"is_deleted"`
+	CreatedAt  time.Time     `json:"created_at"`
+	ReplyCount int64         `json:"reply_count"`
+}
+
+func (q *Queries) ListCommentReplies(ctx context.Context, arg ListCommentRepliesParams) ([]ListCommentRepliesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCommentReplies, arg.ParentID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCommentRepliesRow{}
+	for rows.Next() {
+		var i ListCommentRepliesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.UserID,
+			&i.Username,
+			&i.PostID,
+			&i.ParentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.ReplyCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentTree = `-- name: ListCommentTree :many
+WITH RECURSIVE tree AS (
+    (SELECT c.id, c.content, c.user_id, c.post_id, c.created_at, c.parent_id, c.is_deleted, c.status, c.moderation_reason, 0 AS depth
+     FROM "comments" c
+     WHERE c.post_id = $1
+       AND c.parent_id IS NULL
+       AND c.status = 'approved'
+     ORDER BY c.created_at DESC
+     LIMIT $2 OFFSET $3)
+    UNION ALL
+    SELECT c.id, c.content, c.user_id, c.post_id, c.created_at, c.parent_id, c.is_deleted, c.status, c.moderation_reason, t.depth + 1
+    FROM "comments" c
+             JOIN tree t ON c.parent_id = t.id
+    WHERE c.status = 'approved'
+)
+SELECT t.id,
+       t.content,
+       t.user_id,
+       u.username,
+       t.parent_id,
+       t.is_deleted,
+       t.created_at,
+       t.depth,
+       (SELECT COUNT(*)
+        FROM "comments" r
+        WHERE r.parent_id = t.id
+          AND r.status = 'approved') AS reply_count
+FROM tree t
+         JOIN "users" u ON t.user_id = u.id
+ORDER BY t.depth, CASE WHEN t.depth = 0 THEN t.created_at END DESC, t.created_at
+`
+
+type ListCommentTreeParams struct {
+	PostID int32 `json:"post_id"`
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListCommentTreeRow struct {
+	ID         int64         `json:"id"`
+	Content    string        `json:"content"`
+	UserID     int32         `json:"user_id"`
+	Username   string        `json:"username"`
+	ParentID   sql.NullInt64 `json:"parent_id"`
+	IsDeleted  bool          `json:"is_deleted"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Depth      int32         `json:"depth"`
+	ReplyCount int64         `json:"reply_count"`
+}
+
+func (q *Queries) ListCommentTree(ctx context.Context, arg ListCommentTreeParams) ([]ListCommentTreeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCommentTree, arg.PostID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCommentTreeRow{}
+	for rows.Next() {
+		var i ListCommentTreeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.UserID,
+			&i.Username,
+			&i.ParentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.Depth,
+			&i.ReplyCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCommentsForPost = `-- name: ListCommentsForPost :many
-SELECT c.id, c.content, c.user_id, u.username, c.created_at
+SELECT c.id, c.content, c.user_id, u.username, c.parent_id, c.is_deleted, c.created_at
 FROM "comments" c
          JOIN "users" u ON c.user_id = u.id
 WHERE c.post_id = $1
+  AND c.status = 'approved'
 ORDER BY c.created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -81,11 +302,13 @@ type ListCommentsForPostParams struct {
 }
 
 type ListCommentsForPostRow struct {
-	ID        int64     `json:"id"`
-	Content   string    `json:"content"`
-	UserID    int32     `json:"user_id"`
-	Username  string    `json:"username"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        int64         `json:"id"`
+	Content   string        `json:"content"`
+	UserID    int32         `json:"user_id"`
+	Username  string        `json:"username"`
+	ParentID  sql.NullInt64 `json:"parent_id"`
+	IsDeleted bool          `json:"is_deleted"`
+	CreatedAt time.Time     `json:"created_at"`
 }
 
 func (q *Queries) ListCommentsForPost(ctx context.Context, arg ListCommentsForPostParams) ([]ListCommentsForPostRow, error) {
@@ -102,6 +325,8 @@ func (q *Queries) ListCommentsForPost(ctx context.Context, arg ListCommentsForPo
 			&i.Content,
 			&i.UserID,
 			&i.Username,
+			&i.ParentID,
+			&i.IsDeleted,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -117,20 +342,122 @@ func (q *Queries) ListCommentsForPost(ctx context.Context, arg ListCommentsForPo
 	return items, nil
 }
 
-const updateComment = `-- name: UpdateComment :one
+const listModerationQueue = `-- name: ListModerationQueue :many
+SELECT c.id,
+       c.content,
+       c.user_id,
+       u.username,
+       c.post_id,
+       c.parent_id,
+       c.status,
+       c.moderation_reason,
+       c.created_at
+FROM "comments" c
+         JOIN "users" u ON c.user_id = u.id
+WHERE c.status = $1
+ORDER BY c.created_at
+LIMIT $2 OFFSET $3
+`
+
+type ListModerationQueueParams struct {
+	Status CommentStatus `json:"status"`
+	Limit  int32         `json:"limit"`
+	Offset int32         `json:"offset"`
+}
+
+type ListModerationQueueRow struct {
+	ID               int64         `json:"id"`
+	Content          string        `json:"content"`
+	UserID           int32         `json:"user_id"`
+	Username         string        `json:"username"`
+	PostID           int32         `json:"post_id"`
+	ParentID         sql.NullInt64 `json:"parent_id"`
+	Status           CommentStatus `json:"status"`
+	ModerationReason string        `json:"moderation_reason"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+func (q *Queries) ListModerationQueue(ctx context.Context, arg ListModerationQueueParams) ([]ListModerationQueueRow, error) {
+	rows, err := q.db.QueryContext(ctx, listModerationQueue, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListModerationQueueRow{}
+	for rows.Next() {
+		var i ListModerationQueueRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.UserID,
+			&i.Username,
+			&i.PostID,
+			&i.ParentID,
+			&i.Status,
+			&i.ModerationReason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockComment = `-- name: LockComment :one
+SELECT id
+FROM "comments"
+WHERE id = $1
+    FOR UPDATE
+`
+
+func (q *Queries) LockComment(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, lockComment, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const softDeleteComment = `-- name: SoftDeleteComment :exec
 UPDATE "comments"
-SET content = $2
+SET content    = '[deleted]',
+    is_deleted = true
 WHERE id = $1
-RETURNING id, content, user_id, post_id, created_at
+`
+
+func (q *Queries) SoftDeleteComment(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, softDeleteComment, id)
+	return err
+}
+
+const updateComment = `-- name: UpdateComment :one
+UPDATE "comments"
+SET content           = $1,
+    status            = COALESCE($2, status),
+    moderation_reason = COALESCE($3, moderation_reason)
+WHERE id = $4
+RETURNING id, content, user_id, post_id, created_at, parent_id, is_deleted, status, moderation_reason
 `
 
 type UpdateCommentParams struct {
-	ID      int64  `json:"id"`
-	Content string `json:"content"`
+	Content          string            `json:"content"`
+	Status           NullCommentStatus `json:"status"`
+	ModerationReason sql.NullString    `json:"moderation_reason"`
+	ID               int64             `json:"id"`
 }
 
 func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) (Comment, error) {
-	row := q.db.QueryRowContext(ctx, updateComment, arg.ID, arg.Content)
+	row := q.db.QueryRowContext(ctx, updateComment,
+		arg.Content,
+		arg.Status,
+		arg.ModerationReason,
+		arg.ID,
+	)
 	var i Comment
 	err := row.Scan(
 		&i.ID,
@@ -138,6 +465,46 @@ func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) (C
 		&i.UserID,
 		&i.PostID,
 		&i.CreatedAt,
+		&i.ParentID,
+		&i.IsDeleted,
+		&i.Status,
+		&i.ModerationReason,
 	)
 	return i, err
 }
+
+const updateCommentsStatus = `-- name: UpdateCommentsStatus :many
+UPDATE "comments"
+SET status = $1
+WHERE id = ANY ($2::bigint[])
+  AND status IN ('pending', 'spam', 'rejected')
+RETURNING id
+`
+
+type UpdateCommentsStatusParams struct {
+	Status CommentStatus `json:"status"`
+	Ids    []int64       `json:"ids"`
+}
+
+func (q *Queries) UpdateCommentsStatus(ctx context.Context, arg UpdateCommentsStatusParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, updateCommentsStatus, arg.Status, pq.Array(arg.Ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -2,9 +2,11 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"github.com/aalug/blog-go/utils"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 // createRandomComment creates and returns a random comment
@@ -16,6 +18,7 @@ func createRandomComment(t *testing.T) Comment {
 		Content: utils.RandomString(10),
 		UserID:  int32(user.ID),
 		PostID:  int32(post.ID),
+		Status:  CommentStatusApproved,
 	}
 
 	comment, err := testQueries.CreateComment(context.Background(), params)
@@ -44,6 +47,7 @@ func TestQueries_ListCommentsForPost(t *testing.T) {
 			Content: utils.RandomString(10),
 			UserID:  int32(user.ID),
 			PostID:  int32(post.ID),
+			Status:  CommentStatusApproved,
 		}
 		_, err := testQueries.CreateComment(context.Background(), params)
 		require.NoError(t, err)
@@ -95,6 +99,7 @@ func TestQueries_UpdateComment(t *testing.T) {
 	require.Equal(t, updatedComment.ID, comment.ID)
 	require.Equal(t, updatedComment.UserID, comment.UserID)
 	require.Equal(t, updatedComment.PostID, comment.PostID)
+	require.Equal(t, CommentStatusApproved, updatedComment.Status)
 	require.NotZero(t, updatedComment.CreatedAt)
 }
 
@@ -108,3 +113,140 @@ func TestQueries_GetComment(t *testing.T) {
 	require.Equal(t, comment2.ID, comment.ID)
 	require.Equal(t, comment2.UserID, comment.UserID)
 }
+
+// createRandomReply creates and returns a random reply to the given comment
+func createRandomReply(t *testing.T, parent Comment) Comment {
+	user := createRandomUser(t)
+
+	reply, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		Content:  utils.RandomString(10),
+		UserID:   int32(user.ID),
+		PostID:   parent.PostID,
+		ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+		Status:   CommentStatusApproved,
+	})
+	require.NoError(t, err)
+	require.True(t, reply.ParentID.Valid)
+	require.Equal(t, parent.ID, reply.ParentID.Int64)
+
+	return reply
+}
+
+// TestQueries_ListCommentReplies tests the list comment replies function
+func TestQueries_ListCommentReplies(t *testing.T) {
+	comment := createRandomComment(t)
+	reply := createRandomReply(t, comment)
+	createRandomReply(t, reply)
+	createRandomReply(t, comment)
+
+	replies, err := testQueries.ListCommentReplies(context.Background(), ListCommentRepliesParams{
+		ParentID: sql.NullInt64{Int64: comment.ID, Valid: true},
+		Limit:    5,
+		Offset:   0,
+	})
+	require.NoError(t, err)
+	require.Len(t, replies, 2)
+	require.Equal(t, reply.ID, replies[0].ID)
+	require.Equal(t, int64(1), replies[0].ReplyCount)
+	require.Equal(t, int64(0), replies[1].ReplyCount)
+}
+
+// TestQueries_ListCommentTree tests the list comment tree function
+func TestQueries_ListCommentTree(t *testing.T) {
+	comment := createRandomComment(t)
+	reply := createRandomReply(t, comment)
+	nestedReply := createRandomReply(t, reply)
+
+	rows, err := testQueries.ListCommentTree(context.Background(), ListCommentTreeParams{
+		PostID: comment.PostID,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.Equal(t, comment.ID, rows[0].ID)
+	require.Equal(t, int32(0), rows[0].Depth)
+	require.Equal(t, int64(1), rows[0].ReplyCount)
+
+	require.Equal(t, reply.ID, rows[1].ID)
+	require.Equal(t, int32(1), rows[1].Depth)
+
+	require.Equal(t, nestedReply.ID, rows[2].ID)
+	require.Equal(t, int32(2), rows[2].Depth)
+	require.Equal(t, int64(0), rows[2].ReplyCount)
+}
+
+// TestQueries_ModerationQueue tests the moderation queue functions
+func TestQueries_ModerationQueue(t *testing.T) {
+	user := createRandomUser(t)
+	post := createRandomPost(t)
+
+	pending, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		Content:          utils.RandomString(10),
+		UserID:           int32(user.ID),
+		PostID:           int32(post.ID),
+		Status:           CommentStatusPending,
+		ModerationReason: "first comment of the user",
+	})
+	require.NoError(t, err)
+
+	stats, err := testQueries.GetCommenterStats(context.Background(), GetCommenterStatsParams{
+		UserID: int32(user.ID),
+		Since:  time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.Zero(t, stats.ApprovedComments)
+	require.Equal(t, int64(1), stats.RecentComments)
+
+	// pending comments are not listed
+	comments, err := testQueries.ListCommentsForPost(context.Background(), ListCommentsForPostParams{
+		PostID: int32(post.ID),
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, comments)
+
+	queue, err := testQueries.ListModerationQueue(context.Background(), ListModerationQueueParams{
+		Status: CommentStatusPending,
+		Limit:  100,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, queue)
+
+	approved := createRandomComment(t)
+	updatedIDs, err := testQueries.UpdateCommentsStatus(context.Background(), UpdateCommentsStatusParams{
+		Status: CommentStatusApproved,
+		Ids:    []int64{pending.ID, approved.ID},
+	})
+	require.NoError(t, err)
+	// already approved comments are not in the queue
+	require.Equal(t, []int64{pending.ID}, updatedIDs)
+
+	// rejected comments stay in the queue and can be approved later
+	rejected, err := testQueries.CreateComment(context.Background(), CreateCommentParams{
+		Content: utils.RandomString(10),
+		UserID:  int32(user.ID),
+		PostID:  int32(post.ID),
+		Status:  CommentStatusRejected,
+	})
+	require.NoError(t, err)
+
+	updatedIDs, err = testQueries.UpdateCommentsStatus(context.Background(), UpdateCommentsStatusParams{
+		Status: CommentStatusApproved,
+		Ids:    []int64{rejected.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{rejected.ID}, updatedIDs)
+
+	comments, err = testQueries.ListCommentsForPost(context.Background(), ListCommentsForPostParams{
+		PostID: int32(post.ID),
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	require.ElementsMatch(t, []int64{pending.ID, rejected.ID}, []int64{comments[0].ID, comments[1].ID})
+}
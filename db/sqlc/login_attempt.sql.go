@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: login_attempt.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const deleteLoginAttempt = `-- name: DeleteLoginAttempt :exec
+DELETE
+FROM login_attempts
+WHERE key = $1
+`
+
+func (q *Queries) DeleteLoginAttempt(ctx context.Context, key string) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginAttempt, key)
+	return err
+}
+
+const getLoginAttempts = `-- name: GetLoginAttempts :many
+SELECT key, failed_attempts, last_failed_at, locked_until
+FROM login_attempts
+WHERE key = ANY ($1::varchar[])
+`
+
+func (q *Queries) GetLoginAttempts(ctx context.Context, keys []string) ([]LoginAttempt, error) {
+	rows, err := q.db.QueryContext(ctx, getLoginAttempts, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoginAttempt{}
+	for rows.Next() {
+		var i LoginAttempt
+		if err := rows.Scan(
+			&i.Key,
+			&i.FailedAttempts,
+			&i.LastFailedAt,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockLoginAttempt = `-- name: LockLoginAttempt :exec
+UPDATE login_attempts
+SET locked_until = $2
+WHERE key = $1
+`
+
+type LockLoginAttemptParams struct {
+	Key         string       `json:"key"`
+	LockedUntil sql.NullTime `json:"locked_until"`
+}
+
+func (q *Queries) LockLoginAttempt(ctx context.Context, arg LockLoginAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, lockLoginAttempt, arg.Key, arg.LockedUntil)
+	return err
+}
+
+const recordFailedLoginAttempt = `-- name: RecordFailedLoginAttempt :one
+INSERT INTO login_attempts
+    (key, failed_attempts, last_failed_at)
+VALUES ($1, 1, $2)
+ON CONFLICT (key) DO UPDATE
+    SET failed_attempts = CASE
+                              WHEN login_attempts.last_failed_at < $3::timestamptz THEN 1
+                              ELSE login_attempts.failed_attempts + 1
+        END,
+        last_failed_at  = $2
+RETURNING key, failed_attempts, last_failed_at, locked_until
+`
+
+type RecordFailedLoginAttemptParams struct {
+	Key         string    `json:"key"`
+	FailedAt    time.Time `json:"failed_at"`
+	ResetBefore time.Time `json:"reset_before"`
+}
+
+func (q *Queries) RecordFailedLoginAttempt(ctx context.Context, arg RecordFailedLoginAttemptParams) (LoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, recordFailedLoginAttempt, arg.Key, arg.FailedAt, arg.ResetBefore)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.Key,
+		&i.FailedAttempts,
+		&i.LastFailedAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// TestQueries_LoginAttempt tests that failed attempts are counted within the window,
+// that a key can be locked and that the attempts can be deleted
+func TestQueries_LoginAttempt(t *testing.T) {
+	key := "account:" + utils.RandomEmail()
+	now := time.Now()
+
+	params := RecordFailedLoginAttemptParams{
+		Key:         key,
+		FailedAt:    now,
+		ResetBefore: now.Add(-time.Hour),
+	}
+	for i := 1; i <= 3; i++ {
+		attempt, err := testQueries.RecordFailedLoginAttempt(context.Background(), params)
+		require.NoError(t, err)
+		require.EqualValues(t, i, attempt.FailedAttempts)
+		require.False(t, attempt.LockedUntil.Valid)
+	}
+
+	lockedUntil := now.Add(time.Minute)
+	err := testQueries.LockLoginAttempt(context.Background(), LockLoginAttemptParams{
+		Key:         key,
+		LockedUntil: sql.NullTime{Time: lockedUntil, Valid: true},
+	})
+	require.NoError(t, err)
+
+	attempts, err := testQueries.GetLoginAttempts(context.Background(), []string{key, "ip:" + utils.RandomString(8)})
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	require.WithinDuration(t, lockedUntil, attempts[0].LockedUntil.Time, time.Second)
+
+	// the attempts before the window are forgotten
+	params.FailedAt = now.Add(2 * time.Hour)
+	params.ResetBefore = now.Add(time.Hour)
+	attempt, err := testQueries.RecordFailedLoginAttempt(context.Background(), params)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, attempt.FailedAttempts)
+
+	err = testQueries.DeleteLoginAttempt(context.Background(), key)
+	require.NoError(t, err)
+
+	attempts, err = testQueries.GetLoginAttempts(context.Background(), []string{key})
+	require.NoError(t, err)
+	require.Empty(t, attempts)
+}
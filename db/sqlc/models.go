@@ -5,11 +5,147 @@
 package db
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusRejected CommentStatus = "rejected"
+	CommentStatusSpam     CommentStatus = "spam"
+)
+
+func (e *CommentStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CommentStatus(s)
+	case string:
+		*e = CommentStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CommentStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCommentStatus struct {
+	CommentStatus CommentStatus
+	Valid         bool // Valid is true if CommentStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCommentStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CommentStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CommentStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCommentStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CommentStatus), nil
+}
+
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusScheduled PostStatus = "scheduled"
+	PostStatusPublished PostStatus = "published"
+	PostStatusArchived  PostStatus = "archived"
+)
+
+func (e *PostStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PostStatus(s)
+	case string:
+		*e = PostStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PostStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPostStatus struct {
+	PostStatus PostStatus
+	Valid      bool // Valid is true if PostStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPostStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PostStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PostStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPostStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PostStatus), nil
+}
+
+type UserRole string
+
+const (
+	UserRoleReader    UserRole = "reader"
+	UserRoleAuthor    UserRole = "author"
+	UserRoleModerator UserRole = "moderator"
+	UserRoleAdmin     UserRole = "admin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
 type Category struct {
 	ID        int64     `json:"id"`
 	Name      string    `json:"name"`
@@ -17,23 +153,72 @@ type Category struct {
 }
 
 type Comment struct {
-	ID        int64     `json:"id"`
-	Content   string    `json:"content"`
-	UserID    int32     `json:"user_id"`
-	PostID    int32     `json:"post_id"`
-	CreatedAt time.Time `json:"created_at"`
+	ID               int64         `json:"id"`
+	Content          string        `json:"content"`
+	UserID           int32         `json:"user_id"`
+	PostID           int32         `json:"post_id"`
+	CreatedAt        time.Time     `json:"created_at"`
+	ParentID         sql.NullInt64 `json:"parent_id"`
+	IsDeleted        bool          `json:"is_deleted"`
+	Status           CommentStatus `json:"status"`
+	ModerationReason string        `json:"moderation_reason"`
+}
+
+type LoginAttempt struct {
+	Key            string       `json:"key"`
+	FailedAttempts int32        `json:"failed_attempts"`
+	LastFailedAt   time.Time    `json:"last_failed_at"`
+	LockedUntil    sql.NullTime `json:"locked_until"`
+}
+
+type OIDCState struct {
+	State        string    `json:"state"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiredAt    time.Time `json:"expired_at"`
+}
+
+type OutboxMessage struct {
+	ID            int64           `json:"id"`
+	TaskType      string          `json:"task_type"`
+	Payload       json.RawMessage `json:"payload"`
+	Queue         string          `json:"queue"`
+	MaxRetry      int32           `json:"max_retry"`
+	ProcessAt     time.Time       `json:"process_at"`
+	Attempts      int32           `json:"attempts"`
+	LastError     string          `json:"last_error"`
+	PublishedAt   sql.NullTime    `json:"published_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	DeadAt        sql.NullTime    `json:"dead_at"`
 }
 
 type Post struct {
-	ID          int64     `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Content     string    `json:"content"`
-	AuthorID    int32     `json:"author_id"`
-	CategoryID  int32     `json:"category_id"`
-	Image       string    `json:"image"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           int64        `json:"id"`
+	Title        string       `json:"title"`
+	Description  string       `json:"description"`
+	Content      string       `json:"content"`
+	AuthorID     int32        `json:"author_id"`
+	CategoryID   int32        `json:"category_id"`
+	Image        string       `json:"image"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+	SearchVector string       `json:"-"`
+	Status       PostStatus   `json:"status"`
+	PublishAt    sql.NullTime `json:"publish_at"`
+}
+
+type PostRevision struct {
+	ID           int64         `json:"id"`
+	PostID       int64         `json:"post_id"`
+	Revision     int32         `json:"revision"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	Content      string        `json:"content"`
+	EditorID     int32         `json:"editor_id"`
+	RestoredFrom sql.NullInt32 `json:"restored_from"`
+	CreatedAt    time.Time     `json:"created_at"`
 }
 
 type PostTag struct {
@@ -41,15 +226,34 @@ type PostTag struct {
 	TagID  int32 `json:"tag_id"`
 }
 
+type RecoveryCode struct {
+	ID         int64        `json:"id"`
+	Email      string       `json:"email"`
+	HashedCode string       `json:"hashed_code"`
+	UsedAt     sql.NullTime `json:"used_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type ResetPassword struct {
+	ID         int64     `json:"id"`
+	Email      string    `json:"email"`
+	SecretCode string    `json:"secret_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiredAt  time.Time `json:"expired_at"`
+}
+
 type Session struct {
-	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
-	RefreshToken string    `json:"refresh_token"`
-	UserAgent    string    `json:"user_agent"`
-	ClientIp     string    `json:"client_ip"`
-	IsBlocked    bool      `json:"is_blocked"`
-	ExpiresAt    time.Time `json:"expires_at"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID           uuid.UUID     `json:"id"`
+	Email        string        `json:"email"`
+	RefreshToken string        `json:"refresh_token"`
+	UserAgent    string        `json:"user_agent"`
+	ClientIp     string        `json:"client_ip"`
+	IsBlocked    bool          `json:"is_blocked"`
+	ExpiresAt    time.Time     `json:"expires_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	FamilyID     uuid.UUID     `json:"family_id"`
+	ReplacedBy   uuid.NullUUID `json:"replaced_by"`
 }
 
 type Tag struct {
@@ -57,6 +261,24 @@ type Tag struct {
 	Name string `json:"name"`
 }
 
+type TwoFactorChallenge struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Attempts  int32     `json:"attempts"`
+	IsUsed    bool      `json:"is_used"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+type TwoFactorSecret struct {
+	Email        string       `json:"email"`
+	Secret       string       `json:"secret"`
+	IsEnabled    bool         `json:"is_enabled"`
+	EnabledAt    sql.NullTime `json:"enabled_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+	LastUsedStep int64        `json:"last_used_step"`
+}
+
 type User struct {
 	ID                int64     `json:"id"`
 	Username          string    `json:"username"`
@@ -65,6 +287,16 @@ type User struct {
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
+	Role              UserRole  `json:"role"`
+	IsDisabled        bool      `json:"is_disabled"`
+}
+
+type UserIdentity struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type VerifyEmail struct {
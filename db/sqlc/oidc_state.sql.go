@@ -0,0 +1,57 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: oidc_state.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createOIDCState = `-- name: CreateOIDCState :one
+INSERT INTO oidc_states
+    (state, nonce, code_verifier)
+VALUES ($1, $2, $3)
+RETURNING state, nonce, code_verifier, created_at, expired_at
+`
+
+type CreateOIDCStateParams struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+func (q *Queries) CreateOIDCState(ctx context.Context, arg CreateOIDCStateParams) (OIDCState, error) {
+	row := q.db.QueryRowContext(ctx, createOIDCState, arg.State, arg.Nonce, arg.CodeVerifier)
+	var i OIDCState
+	err := row.Scan(
+		&i.State,
+		&i.Nonce,
+		&i.CodeVerifier,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const useOIDCState = `-- name: UseOIDCState :one
+DELETE
+FROM oidc_states
+WHERE state = $1
+  AND expired_at > now()
+RETURNING state, nonce, code_verifier, created_at, expired_at
+`
+
+func (q *Queries) UseOIDCState(ctx context.Context, state string) (OIDCState, error) {
+	row := q.db.QueryRowContext(ctx, useOIDCState, state)
+	var i OIDCState
+	err := row.Scan(
+		&i.State,
+		&i.Nonce,
+		&i.CodeVerifier,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
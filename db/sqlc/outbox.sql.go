@@ -0,0 +1,200 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: outbox.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const createOutboxMessage = `-- name: CreateOutboxMessage :one
+INSERT INTO outbox (task_type,
+                    payload,
+                    queue,
+                    max_retry,
+                    process_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, task_type, payload, queue, max_retry, process_at, attempts, last_error, published_at, created_at, next_attempt_at, dead_at
+`
+
+type CreateOutboxMessageParams struct {
+	TaskType  string          `json:"task_type"`
+	Payload   json.RawMessage `json:"payload"`
+	Queue     string          `json:"queue"`
+	MaxRetry  int32           `json:"max_retry"`
+	ProcessAt time.Time       `json:"process_at"`
+}
+
+func (q *Queries) CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) (OutboxMessage, error) {
+	row := q.db.QueryRowContext(ctx, createOutboxMessage,
+		arg.TaskType,
+		arg.Payload,
+		arg.Queue,
+		arg.MaxRetry,
+		arg.ProcessAt,
+	)
+	var i OutboxMessage
+	err := row.Scan(
+		&i.ID,
+		&i.TaskType,
+		&i.Payload,
+		&i.Queue,
+		&i.MaxRetry,
+		&i.ProcessAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.PublishedAt,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeadAt,
+	)
+	return i, err
+}
+
+const deletePublishedOutboxMessages = `-- name: DeletePublishedOutboxMessages :execrows
+DELETE
+FROM outbox
+WHERE published_at < $1::timestamptz
+`
+
+// deletes the messages published before the time
+func (q *Queries) DeletePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePublishedOutboxMessages, publishedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOutboxMessage = `-- name: GetOutboxMessage :one
+SELECT id, task_type, payload, queue, max_retry, process_at, attempts, last_error, published_at, created_at, next_attempt_at, dead_at
+FROM outbox
+WHERE id = $1
+`
+
+func (q *Queries) GetOutboxMessage(ctx context.Context, id int64) (OutboxMessage, error) {
+	row := q.db.QueryRowContext(ctx, getOutboxMessage, id)
+	var i OutboxMessage
+	err := row.Scan(
+		&i.ID,
+		&i.TaskType,
+		&i.Payload,
+		&i.Queue,
+		&i.MaxRetry,
+		&i.ProcessAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.PublishedAt,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeadAt,
+	)
+	return i, err
+}
+
+const listPendingOutboxMessages = `-- name: ListPendingOutboxMessages :many
+SELECT id, task_type, payload, queue, max_retry, process_at, attempts, last_error, published_at, created_at, next_attempt_at, dead_at
+FROM outbox
+WHERE published_at IS NULL
+  AND dead_at IS NULL
+  AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $1 FOR UPDATE SKIP LOCKED
+`
+
+// locks the pending messages due to be published until the end of the transaction,
+// so concurrent relays publish different messages
+func (q *Queries) ListPendingOutboxMessages(ctx context.Context, limit int32) ([]OutboxMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingOutboxMessages, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxMessage{}
+	for rows.Next() {
+		var i OutboxMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskType,
+			&i.Payload,
+			&i.Queue,
+			&i.MaxRetry,
+			&i.ProcessAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.PublishedAt,
+			&i.CreatedAt,
+			&i.NextAttemptAt,
+			&i.DeadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxMessagePublished = `-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = now(),
+    attempts     = attempts + 1
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxMessagePublished(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, markOutboxMessagePublished, id)
+	return err
+}
+
+const recordOutboxMessageFailure = `-- name: RecordOutboxMessageFailure :one
+UPDATE outbox
+SET attempts        = attempts + 1,
+    last_error      = $1,
+    next_attempt_at = now() + make_interval(secs => $2::int),
+    dead_at         = CASE WHEN attempts + 1 >= $3::int THEN now() END
+WHERE id = $4
+RETURNING id, task_type, payload, queue, max_retry, process_at, attempts, last_error, published_at, created_at, next_attempt_at, dead_at
+`
+
+type RecordOutboxMessageFailureParams struct {
+	LastError         string `json:"last_error"`
+	RetryDelaySeconds int32  `json:"retry_delay_seconds"`
+	MaxAttempts       int32  `json:"max_attempts"`
+	ID                int64  `json:"id"`
+}
+
+// retries the message after the delay or, if it has failed max_attempts times, marks it as dead
+func (q *Queries) RecordOutboxMessageFailure(ctx context.Context, arg RecordOutboxMessageFailureParams) (OutboxMessage, error) {
+	row := q.db.QueryRowContext(ctx, recordOutboxMessageFailure,
+		arg.LastError,
+		arg.RetryDelaySeconds,
+		arg.MaxAttempts,
+		arg.ID,
+	)
+	var i OutboxMessage
+	err := row.Scan(
+		&i.ID,
+		&i.TaskType,
+		&i.Payload,
+		&i.Queue,
+		&i.MaxRetry,
+		&i.ProcessAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.PublishedAt,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeadAt,
+	)
+	return i, err
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// createRandomOutboxMessage creates and returns a random pending outbox message
+func createRandomOutboxMessage(t *testing.T) OutboxMessage {
+	params := CreateOutboxMessageParams{
+		TaskType:  "task:" + utils.RandomString(6),
+		Payload:   json.RawMessage(`{"email":"` + utils.RandomEmail() + `"}`),
+		Queue:     "default",
+		MaxRetry:  10,
+		ProcessAt: time.Now(),
+	}
+
+	message, err := testQueries.CreateOutboxMessage(context.Background(), params)
+	require.NoError(t, err)
+	require.NotZero(t, message.ID)
+	require.Equal(t, params.TaskType, message.TaskType)
+	require.JSONEq(t, string(params.Payload), string(message.Payload))
+	require.Equal(t, params.Queue, message.Queue)
+	require.Equal(t, params.MaxRetry, message.MaxRetry)
+	require.WithinDuration(t, params.ProcessAt, message.ProcessAt, time.Second)
+	require.Zero(t, message.Attempts)
+	require.False(t, message.PublishedAt.Valid)
+	require.NotZero(t, message.CreatedAt)
+
+	return message
+}
+
+func TestQueries_CreateOutboxMessage(t *testing.T) {
+	createRandomOutboxMessage(t)
+}
+
+func TestQueries_DeletePublishedOutboxMessages(t *testing.T) {
+	message := createRandomOutboxMessage(t)
+	err := testQueries.MarkOutboxMessagePublished(context.Background(), message.ID)
+	require.NoError(t, err)
+
+	// the pending messages are never deleted
+	pendingMessage := createRandomOutboxMessage(t)
+
+	deleted, err := testQueries.DeletePublishedOutboxMessages(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = testQueries.GetOutboxMessage(context.Background(), message.ID)
+	require.Error(t, err)
+
+	_, err = testQueries.GetOutboxMessage(context.Background(), pendingMessage.ID)
+	require.NoError(t, err)
+}
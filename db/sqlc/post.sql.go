@@ -7,25 +7,42 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/lib/pq"
 )
 
+const countSearchPosts = `-- name: CountSearchPosts :one
+SELECT count(*)
+FROM posts
+WHERE search_vector @@ websearch_to_tsquery('english', $1::text)
+  AND status = 'published'
+`
+
+func (q *Queries) CountSearchPosts(ctx context.Context, query string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchPosts, query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPost = `-- name: CreatePost :one
 INSERT INTO posts
-    (title, description, content, author_id, category_id, image)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, title, description, content, author_id, category_id, image, created_at, updated_at
+    (title, description, content, author_id, category_id, image, status, publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, title, description, content, author_id, category_id, image, created_at, updated_at, search_vector, status, publish_at
 `
 
 type CreatePostParams struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Content     string `json:"content"`
-	AuthorID    int32  `json:"author_id"`
-	CategoryID  int32  `json:"category_id"`
-	Image       string `json:"image"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Content     string       `json:"content"`
+	AuthorID    int32        `json:"author_id"`
+	CategoryID  int32        `json:"category_id"`
+	Image       string       `json:"image"`
+	Status      PostStatus   `json:"status"`
+	PublishAt   sql.NullTime `json:"publish_at"`
 }
 
 func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, error) {
@@ -36,6 +53,8 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		arg.AuthorID,
 		arg.CategoryID,
 		arg.Image,
+		arg.Status,
+		arg.PublishAt,
 	)
 	var i Post
 	err := row.Scan(
@@ -48,6 +67,9 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		&i.Image,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SearchVector,
+		&i.Status,
+		&i.PublishAt,
 	)
 	return i, err
 }
@@ -66,20 +88,22 @@ func (q *Queries) DeletePost(ctx context.Context, id int64) error {
 const getMinimalPostData = `-- name: GetMinimalPostData :one
 SELECT
     id,
-    author_id
+    author_id,
+    status
 FROM posts
 WHERE id = $1
 `
 
 type GetMinimalPostDataRow struct {
-	ID       int64 `json:"id"`
-	AuthorID int32 `json:"author_id"`
+	ID       int64      `json:"id"`
+	AuthorID int32      `json:"author_id"`
+	Status   PostStatus `json:"status"`
 }
 
 func (q *Queries) GetMinimalPostData(ctx context.Context, id int64) (GetMinimalPostDataRow, error) {
 	row := q.db.QueryRowContext(ctx, getMinimalPostData, id)
 	var i GetMinimalPostDataRow
-	err := row.Scan(&i.ID, &i.AuthorID)
+	err := row.Scan(&i.ID, &i.AuthorID, &i.Status)
 	return i, err
 }
 
@@ -89,8 +113,11 @@ SELECT p.id,
        p.description,
        p.content,
        u.username AS author_username,
+       u.email    AS author_email,
        c.name     AS category_name,
        p.image,
+       p.status,
+       p.publish_at,
        p.created_at
 FROM posts p
          JOIN users u ON p.author_id = u.id
@@ -99,14 +126,17 @@ WHERE p.id = $1
 `
 
 type GetPostByIDRow struct {
-	ID             int64     `json:"id"`
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	Content        string    `json:"content"`
-	AuthorUsername string    `json:"author_username"`
-	CategoryName   string    `json:"category_name"`
-	Image          string    `json:"image"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             int64        `json:"id"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Content        string       `json:"content"`
+	AuthorUsername string       `json:"author_username"`
+	AuthorEmail    string       `json:"author_email"`
+	CategoryName   string       `json:"category_name"`
+	Image          string       `json:"image"`
+	Status         PostStatus   `json:"status"`
+	PublishAt      sql.NullTime `json:"publish_at"`
+	CreatedAt      time.Time    `json:"created_at"`
 }
 
 func (q *Queries) GetPostByID(ctx context.Context, id int64) (GetPostByIDRow, error) {
@@ -118,8 +148,11 @@ func (q *Queries) GetPostByID(ctx context.Context, id int64) (GetPostByIDRow, er
 		&i.Description,
 		&i.Content,
 		&i.AuthorUsername,
+		&i.AuthorEmail,
 		&i.CategoryName,
 		&i.Image,
+		&i.Status,
+		&i.PublishAt,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -131,8 +164,11 @@ SELECT p.id,
        p.description,
        p.content,
        u.username AS author_username,
+       u.email    AS author_email,
        c.name     AS category_name,
        p.image,
+       p.status,
+       p.publish_at,
        p.created_at
 FROM posts p
          JOIN users u ON p.author_id = u.id
@@ -141,14 +177,17 @@ WHERE p.title = $1
 `
 
 type GetPostByTitleRow struct {
-	ID             int64     `json:"id"`
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	Content        string    `json:"content"`
-	AuthorUsername string    `json:"author_username"`
-	CategoryName   string    `json:"category_name"`
-	Image          string    `json:"image"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             int64        `json:"id"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Content        string       `json:"content"`
+	AuthorUsername string       `json:"author_username"`
+	AuthorEmail    string       `json:"author_email"`
+	CategoryName   string       `json:"category_name"`
+	Image          string       `json:"image"`
+	Status         PostStatus   `json:"status"`
+	PublishAt      sql.NullTime `json:"publish_at"`
+	CreatedAt      time.Time    `json:"created_at"`
 }
 
 func (q *Queries) GetPostByTitle(ctx context.Context, title string) (GetPostByTitleRow, error) {
@@ -160,15 +199,94 @@ func (q *Queries) GetPostByTitle(ctx context.Context, title string) (GetPostByTi
 		&i.Description,
 		&i.Content,
 		&i.AuthorUsername,
+		&i.AuthorEmail,
 		&i.CategoryName,
 		&i.Image,
+		&i.Status,
+		&i.PublishAt,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const listOwnPosts = `-- name: ListOwnPosts :many
+SELECT p.id,
+       p.title,
+       p.description,
+       c.name AS category_name,
+       p.image,
+       p.status,
+       p.publish_at,
+       p.created_at,
+       p.updated_at
+FROM posts p
+         JOIN categories c ON p.category_id = c.id
+WHERE p.author_id = $1
+  AND ($2::post_status IS NULL OR p.status = $2)
+ORDER BY p.updated_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListOwnPostsParams struct {
+	AuthorID   int32          `json:"author_id"`
+	Status     NullPostStatus `json:"status"`
+	PageOffset int32          `json:"page_offset"`
+	PageLimit  int32          `json:"page_limit"`
+}
+
+type ListOwnPostsRow struct {
+	ID           int64        `json:"id"`
+	Title        string       `json:"title"`
+	Description  string       `json:"description"`
+	CategoryName string       `json:"category_name"`
+	Image        string       `json:"image"`
+	Status       PostStatus   `json:"status"`
+	PublishAt    sql.NullTime `json:"publish_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+func (q *Queries) ListOwnPosts(ctx context.Context, arg ListOwnPostsParams) ([]ListOwnPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOwnPosts,
+		arg.AuthorID,
+		arg.Status,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOwnPostsRow{}
+	for rows.Next() {
+		var i ListOwnPostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.CategoryName,
+			&i.Image,
+			&i.Status,
+			&i.PublishAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPosts = `-- name: ListPosts :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -177,6 +295,7 @@ SELECT p.title,
 FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
+WHERE p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -187,6 +306,7 @@ type ListPostsParams struct {
 }
 
 type ListPostsRow struct {
+	ID             int64     `json:"id"`
 	Title          string    `json:"title"`
 	Description    string    `json:"description"`
 	AuthorUsername string    `json:"author_username"`
@@ -205,6 +325,7 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 	for rows.Next() {
 		var i ListPostsRow
 		if err := rows.Scan(
+			&i.ID,
 			&i.Title,
 			&i.Description,
 			&i.AuthorUsername,
@@ -226,7 +347,8 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 }
 
 const listPostsByAuthor = `-- name: ListPostsByAuthor :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -236,6 +358,7 @@ FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
 WHERE p.author_id = $1
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -247,6 +370,7 @@ type ListPostsByAuthorParams struct {
 }
 
 type ListPostsByAuthorRow struct {
+	ID             int64     `json:"id"`
 	Title          string    `json:"title"`
 	Description    string    `json:"description"`
 	AuthorUsername string    `json:"author_username"`
@@ -265,6 +389,7 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 	for rows.Next() {
 		var i ListPostsByAuthorRow
 		if err := rows.Scan(
+			&i.ID,
 			&i.Title,
 			&i.Description,
 			&i.AuthorUsername,
@@ -286,7 +411,8 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 }
 
 const listPostsByCategory = `-- name: ListPostsByCategory :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -296,6 +422,7 @@ FROM posts p
          JOIN users u ON p.author_id = u.id
          JOIN categories c ON p.category_id = c.id
 WHERE c.id = $1
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -307,6 +434,7 @@ type ListPostsByCategoryParams struct {
 }
 
 type ListPostsByCategoryRow struct {
+	ID             int64     `json:"id"`
 	Title          string    `json:"title"`
 	Description    string    `json:"description"`
 	AuthorUsername string    `json:"author_username"`
@@ -325,6 +453,7 @@ func (q *Queries) ListPostsByCategory(ctx context.Context, arg ListPostsByCatego
 	for rows.Next() {
 		var i ListPostsByCategoryRow
 		if err := rows.Scan(
+			&i.ID,
 			&i.Title,
 			&i.Description,
 			&i.AuthorUsername,
@@ -346,7 +475,8 @@ func (q *Queries) ListPostsByCategory(ctx context.Context, arg ListPostsByCatego
 }
 
 const listPostsByTags = `-- name: ListPostsByTags :many
-SELECT p.title,
+SELECT p.id,
+       p.title,
        p.description,
        u.username AS author_username,
        c.name     AS category_name,
@@ -358,6 +488,7 @@ FROM posts p
          JOIN post_tags pt ON p.id = pt.post_id
          JOIN tags t ON pt.tag_id = t.id
 WHERE t.id = ANY ($3::int[])
+  AND p.status = 'published'
 ORDER BY p.created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -369,6 +500,7 @@ type ListPostsByTagsParams struct {
 }
 
 type ListPostsByTagsRow struct {
+	ID             int64     `json:"id"`
 	Title          string    `json:"title"`
 	Description    string    `json:"description"`
 	AuthorUsername string    `json:"author_username"`
@@ -387,6 +519,7 @@ func (q *Queries) ListPostsByTags(ctx context.Context, arg ListPostsByTagsParams
 	for rows.Next() {
 		var i ListPostsByTagsRow
 		if err := rows.Scan(
+			&i.ID,
 			&i.Title,
 			&i.Description,
 			&i.AuthorUsername,
@@ -407,6 +540,142 @@ func (q *Queries) ListPostsByTags(ctx context.Context, arg ListPostsByTagsParams
 	return items, nil
 }
 
+const movePostsToCategory = `-- name: MovePostsToCategory :execrows
+UPDATE posts
+SET category_id = $1
+WHERE category_id = $2
+`
+
+type MovePostsToCategoryParams struct {
+	ToCategoryID   int32 `json:"to_category_id"`
+	FromCategoryID int32 `json:"from_category_id"`
+}
+
+// moves all posts of a category to another category, returns the number of moved posts
+func (q *Queries) MovePostsToCategory(ctx context.Context, arg MovePostsToCategoryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, movePostsToCategory, arg.ToCategoryID, arg.FromCategoryID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const publishScheduledPosts = `-- name: PublishScheduledPosts :many
+UPDATE posts
+SET status     = 'published',
+    updated_at = now()
+WHERE status = 'scheduled'
+  AND publish_at <= now()
+RETURNING id
+`
+
+func (q *Queries) PublishScheduledPosts(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, publishScheduledPosts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPosts = `-- name: SearchPosts :many
+SELECT p.id,
+       p.title,
+       p.description,
+       u.username                                       AS author_username,
+       c.name                                           AS category_name,
+       p.image,
+       p.created_at,
+       ts_rank(p.search_vector,
+               websearch_to_tsquery('english', $1::text))::real AS rank,
+       -- the title and the content are HTML-escaped before the highlighting,
+       -- so the only HTML in the highlighted title and the snippet are the <mark> tags
+       ts_headline('english',
+                   replace(replace(replace(replace(p.title,
+                       '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+                   websearch_to_tsquery('english', $1::text),
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS highlighted_title,
+       ts_headline('english',
+                   replace(replace(replace(replace(p.content,
+                       '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+                   websearch_to_tsquery('english', $1::text),
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10')::text AS snippet
+FROM posts p
+         JOIN users u ON p.author_id = u.id
+         JOIN categories c ON p.category_id = c.id
+WHERE p.search_vector @@ websearch_to_tsquery('english', $1::text)
+  AND p.status = 'published'
+ORDER BY rank DESC, p.created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type SearchPostsParams struct {
+	Query      string `json:"query"`
+	PageOffset int32  `json:"page_offset"`
+	PageLimit  int32  `json:"page_limit"`
+}
+
+type SearchPostsRow struct {
+	ID               int64     `json:"id"`
+	Title            string    `json:"title"`
+	Description      string    `json:"description"`
+	AuthorUsername   string    `json:"author_username"`
+	CategoryName     string    `json:"category_name"`
+	Image            string    `json:"image"`
+	CreatedAt        time.Time `json:"created_at"`
+	Rank             float32   `json:"rank"`
+	HighlightedTitle string    `json:"highlighted_title"`
+	Snippet          string    `json:"snippet"`
+}
+
+func (q *Queries) SearchPosts(ctx context.Context, arg SearchPostsParams) ([]SearchPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchPosts, arg.Query, arg.PageOffset, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchPostsRow{}
+	for rows.Next() {
+		var i SearchPostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.AuthorUsername,
+			&i.CategoryName,
+			&i.Image,
+			&i.CreatedAt,
+			&i.Rank,
+			&i.HighlightedTitle,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePost = `-- name: UpdatePost :one
 UPDATE posts
 SET title       = COALESCE($2, title),
@@ -416,7 +685,7 @@ SET title       = COALESCE($2, title),
     image       = COALESCE($6, image),
     updated_at  = $7
 WHERE id = $1
-RETURNING id, title, description, content, author_id, category_id, image, created_at, updated_at
+RETURNING id, title, description, content, author_id, category_id, image, created_at, updated_at, search_vector, status, publish_at
 `
 
 type UpdatePostParams struct {
@@ -450,6 +719,44 @@ func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) (Post, e
 		&i.Image,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SearchVector,
+		&i.Status,
+		&i.PublishAt,
+	)
+	return i, err
+}
+
+const updatePostStatus = `-- name: UpdatePostStatus :one
+UPDATE posts
+SET status     = $2,
+    publish_at = $3,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, title, description, content, author_id, category_id, image, created_at, updated_at, search_vector, status, publish_at
+`
+
+type UpdatePostStatusParams struct {
+	ID        int64        `json:"id"`
+	Status    PostStatus   `json:"status"`
+	PublishAt sql.NullTime `json:"publish_at"`
+}
+
+func (q *Queries) UpdatePostStatus(ctx context.Context, arg UpdatePostStatusParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, updatePostStatus, arg.ID, arg.Status, arg.PublishAt)
+	var i Post
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.AuthorID,
+		&i.CategoryID,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SearchVector,
+		&i.Status,
+		&i.PublishAt,
 	)
 	return i, err
 }
@@ -0,0 +1,189 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: post_revision.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countPostRevisions = `-- name: CountPostRevisions :one
+SELECT COUNT(*)
+FROM "post_revisions"
+WHERE post_id = $1
+`
+
+func (q *Queries) CountPostRevisions(ctx context.Context, postID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPostRevisions, postID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPostRevision = `-- name: CreatePostRevision :one
+INSERT INTO "post_revisions"
+    (post_id, revision, title, description, content, editor_id, restored_from)
+SELECT $1,
+       COALESCE(MAX(revision), 0) + 1,
+       $2,
+       $3,
+       $4,
+       $5,
+       $6
+FROM "post_revisions"
+WHERE post_id = $1
+RETURNING id, post_id, revision, title, description, content, editor_id, restored_from, created_at
+`
+
+type CreatePostRevisionParams struct {
+	PostID       int64         `json:"post_id"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	Content      string        `json:"content"`
+	EditorID     int32         `json:"editor_id"`
+	RestoredFrom sql.NullInt32 `json:"restored_from"`
+}
+
+func (q *Queries) CreatePostRevision(ctx context.Context, arg CreatePostRevisionParams) (PostRevision, error) {
+	row := q.db.QueryRowContext(ctx, createPostRevision,
+		arg.PostID,
+		arg.Title,
+		arg.Description,
+		arg.Content,
+		arg.EditorID,
+		arg.RestoredFrom,
+	)
+	var i PostRevision
+	err := row.Scan(
+		&i.ID,
+		&i.PostID,
+		&i.Revision,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.EditorID,
+		&i.RestoredFrom,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPostForUpdate = `-- name: GetPostForUpdate :one
+SELECT id, title, description, content, author_id, category_id, image, created_at, updated_at, search_vector, status, publish_at
+FROM posts
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPostForUpdate(ctx context.Context, id int64) (Post, error) {
+	row := q.db.QueryRowContext(ctx, getPostForUpdate, id)
+	var i Post
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.AuthorID,
+		&i.CategoryID,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SearchVector,
+		&i.Status,
+		&i.PublishAt,
+	)
+	return i, err
+}
+
+const getPostRevision = `-- name: GetPostRevision :one
+SELECT id, post_id, revision, title, description, content, editor_id, restored_from, created_at
+FROM "post_revisions"
+WHERE post_id = $1
+  AND revision = $2
+`
+
+type GetPostRevisionParams struct {
+	PostID   int64 `json:"post_id"`
+	Revision int32 `json:"revision"`
+}
+
+func (q *Queries) GetPostRevision(ctx context.Context, arg GetPostRevisionParams) (PostRevision, error) {
+	row := q.db.QueryRowContext(ctx, getPostRevision, arg.PostID, arg.Revision)
+	var i PostRevision
+	err := row.Scan(
+		&i.ID,
+		&i.PostID,
+		&i.Revision,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.EditorID,
+		&i.RestoredFrom,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listPostRevisions = `-- name: ListPostRevisions :many
+SELECT r.id,
+       r.revision,
+       r.title,
+       u.username AS editor_username,
+       r.restored_from,
+       r.created_at
+FROM "post_revisions" r
+         JOIN "users" u ON r.editor_id = u.id
+WHERE r.post_id = $1
+ORDER BY r.revision DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListPostRevisionsParams struct {
+	PostID int64 `json:"post_id"`
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListPostRevisionsRow struct {
+	ID             int64         `json:"id"`
+	Revision       int32         `json:"revision"`
+	Title          string        `json:"title"`
+	EditorUsername string        `json:"editor_username"`
+	RestoredFrom   sql.NullInt32 `json:"restored_from"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+func (q *Queries) ListPostRevisions(ctx context.Context, arg ListPostRevisionsParams) ([]ListPostRevisionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPostRevisions, arg.PostID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPostRevisionsRow{}
+	for rows.Next() {
+		var i ListPostRevisionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Revision,
+			&i.Title,
+			&i.EditorUsername,
+			&i.RestoredFrom,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
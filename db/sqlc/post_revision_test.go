@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// createRandomPostRevision creates and returns a random revision of the given post
+func createRandomPostRevision(t *testing.T, post Post) PostRevision {
+	params := CreatePostRevisionParams{
+		PostID:      post.ID,
+		Title:       utils.RandomString(6),
+		Description: utils.RandomString(7),
+		Content:     utils.RandomString(10),
+		EditorID:    post.AuthorID,
+	}
+
+	revision, err := testQueries.CreatePostRevision(context.Background(), params)
+	require.NoError(t, err)
+	require.NotEmpty(t, revision)
+	require.NotZero(t, revision.ID)
+	require.Equal(t, params.PostID, revision.PostID)
+	require.Equal(t, params.Title, revision.Title)
+	require.Equal(t, params.Description, revision.Description)
+	require.Equal(t, params.Content, revision.Content)
+	require.Equal(t, params.EditorID, revision.EditorID)
+	require.False(t, revision.RestoredFrom.Valid)
+	require.NotZero(t, revision.CreatedAt)
+
+	return revision
+}
+
+// TestQueries_CreatePostRevision tests the create post revision function
+func TestQueries_CreatePostRevision(t *testing.T) {
+	post := createRandomPost(t)
+
+	// revision numbers are consecutive within a post
+	for i := 1; i <= 3; i++ {
+		revision := createRandomPostRevision(t, post)
+		require.Equal(t, int32(i), revision.Revision)
+	}
+
+	otherPost := createRandomPost(t)
+	revision := createRandomPostRevision(t, otherPost)
+	require.Equal(t, int32(1), revision.Revision)
+}
+
+// TestQueries_GetPostRevision tests the get post revision function
+func TestQueries_GetPostRevision(t *testing.T) {
+	post := createRandomPost(t)
+	revision := createRandomPostRevision(t, post)
+
+	revision2, err := testQueries.GetPostRevision(context.Background(), GetPostRevisionParams{
+		PostID:   post.ID,
+		Revision: revision.Revision,
+	})
+	require.NoError(t, err)
+	require.Equal(t, revision.ID, revision2.ID)
+	require.Equal(t, revision.Title, revision2.Title)
+	require.Equal(t, revision.Description, revision2.Description)
+	require.Equal(t, revision.Content, revision2.Content)
+	require.WithinDuration(t, revision.CreatedAt, revision2.CreatedAt, time.Second)
+
+	_, err = testQueries.GetPostRevision(context.Background(), GetPostRevisionParams{
+		PostID:   post.ID,
+		Revision: revision.Revision + 1,
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestQueries_ListPostRevisions tests the list post revisions and the count post revisions functions
+func TestQueries_ListPostRevisions(t *testing.T) {
+	post := createRandomPost(t)
+	for i := 0; i < 6; i++ {
+		createRandomPostRevision(t, post)
+	}
+
+	count, err := testQueries.CountPostRevisions(context.Background(), post.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), count)
+
+	revisions, err := testQueries.ListPostRevisions(context.Background(), ListPostRevisionsParams{
+		PostID: post.ID,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 5)
+
+	// the newest first
+	for i, revision := range revisions {
+		require.Equal(t, int32(6-i), revision.Revision)
+		require.NotEmpty(t, revision.EditorUsername)
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/aalug/blog-go/utils"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -21,6 +22,7 @@ func createRandomPost(t *testing.T) Post {
 		AuthorID:    int32(user.ID),
 		CategoryID:  int32(category.ID),
 		Image:       utils.RandomString(3) + ".png",
+		Status:      PostStatusPublished,
 	}
 
 	post, err := testQueries.CreatePost(context.Background(), params)
@@ -34,6 +36,8 @@ func createRandomPost(t *testing.T) Post {
 	require.Equal(t, post.AuthorID, params.AuthorID)
 	require.Equal(t, post.CategoryID, params.CategoryID)
 	require.Equal(t, post.Image, params.Image)
+	require.Equal(t, post.Status, params.Status)
+	require.False(t, post.PublishAt.Valid)
 	require.NotZero(t, post.CreatedAt)
 	require.NotZero(t, post.UpdatedAt)
 
@@ -122,6 +126,7 @@ func TestQueries_ListPostsByCategory(t *testing.T) {
 			AuthorID:    int32(user.ID),
 			CategoryID:  int32(categoryID),
 			Image:       "test.jpg",
+			Status:      PostStatusPublished,
 		}
 		_, err := testQueries.CreatePost(context.Background(), params)
 		require.NoError(t, err)
@@ -169,6 +174,7 @@ func TestQueries_ListPostsByAuthor(t *testing.T) {
 			AuthorID:    authorID,
 			CategoryID:  categoryID,
 			Image:       "test.jpg",
+			Status:      PostStatusPublished,
 		}
 		_, err := testQueries.CreatePost(context.Background(), params)
 		require.NoError(t, err)
@@ -304,4 +310,187 @@ func TestQueries_GetMinimalPostData(t *testing.T) {
 	require.NotEmpty(t, post2)
 	require.Equal(t, post.ID, post2.ID)
 	require.Equal(t, post.AuthorID, post2.AuthorID)
+	require.Equal(t, post.Status, post2.Status)
+}
+
+// TestQueries_SearchPosts tests the search posts and the count search posts functions
+func TestQueries_SearchPosts(t *testing.T) {
+	user := createRandomUser(t)
+	category := createRandomCategory(t)
+	word := utils.RandomString(12)
+
+	// the word in the title should rank higher than the word in the content
+	titleMatch, err := testQueries.CreatePost(context.Background(), CreatePostParams{
+		Title:       word + " " + utils.RandomString(6),
+		Description: utils.RandomString(7),
+		Content:     utils.RandomString(10),
+		AuthorID:    int32(user.ID),
+		CategoryID:  int32(category.ID),
+		Image:       utils.RandomString(3) + ".png",
+		Status:      PostStatusPublished,
+	})
+	require.NoError(t, err)
+
+	contentMatch, err := testQueries.CreatePost(context.Background(), CreatePostParams{
+		Title:       utils.RandomString(6),
+		Description: utils.RandomString(7),
+		Content:     utils.RandomString(10) + " " + word,
+		AuthorID:    int32(user.ID),
+		CategoryID:  int32(category.ID),
+		Image:       utils.RandomString(3) + ".png",
+		Status:      PostStatusPublished,
+	})
+	require.NoError(t, err)
+
+	total, err := testQueries.CountSearchPosts(context.Background(), word)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+
+	posts, err := testQueries.SearchPosts(context.Background(), SearchPostsParams{
+		Query:      word,
+		PageLimit:  5,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+
+	require.Equal(t, titleMatch.ID, posts[0].ID)
+	require.Equal(t, contentMatch.ID, posts[1].ID)
+	require.Greater(t, posts[0].Rank, posts[1].Rank)
+	require.Contains(t, posts[0].HighlightedTitle, "<mark>"+word+"</mark>")
+	require.Contains(t, posts[1].Snippet, "<mark>"+word+"</mark>")
+	require.Equal(t, user.Username, posts[0].AuthorUsername)
+	require.Equal(t, category.Name, posts[0].CategoryName)
+}
+
+// TestQueries_SearchPostsEscapesHTML tests that the highlighted title and the snippet
+// contain the text of the post HTML-escaped, with only the <mark> tags added
+func TestQueries_SearchPostsEscapesHTML(t *testing.T) {
+	user := createRandomUser(t)
+	category := createRandomCategory(t)
+	word := utils.RandomString(12)
+
+	_, err := testQueries.CreatePost(context.Background(), CreatePostParams{
+		Title:       fmt.Sprintf(`<img src="x" onerror="alert(1)"> %s`, word),
+		Description: utils.RandomString(7),
+		Content:     fmt.Sprintf("<script>alert(1)</script> %s & more", word),
+		AuthorID:    int32(user.ID),
+		CategoryID:  int32(category.ID),
+		Image:       utils.RandomString(3) + ".png",
+		Status:      PostStatusPublished,
+	})
+	require.NoError(t, err)
+
+	posts, err := testQueries.SearchPosts(context.Background(), SearchPostsParams{
+		Query:      word,
+		PageLimit:  5,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+
+	require.Contains(t, posts[0].HighlightedTitle, "&lt;img src=&quot;x&quot; onerror=&quot;alert(1)&quot;&gt;")
+	require.Contains(t, posts[0].HighlightedTitle, "<mark>"+word+"</mark>")
+	require.NotContains(t, posts[0].HighlightedTitle, "<img")
+
+	require.Contains(t, posts[0].Snippet, "&lt;script&gt;")
+	require.Contains(t, posts[0].Snippet, "<mark>"+word+"</mark> &amp; more")
+	require.NotContains(t, posts[0].Snippet, "<script>")
+}
+
+// TestQueries_ListOwnPosts tests the list own posts function
+func TestQueries_ListOwnPosts(t *testing.T) {
+	user := createRandomUser(t)
+	category := createRandomCategory(t)
+
+	statuses := []PostStatus{PostStatusDraft, PostStatusPublished, PostStatusArchived, PostStatusDraft}
+	for _, status := range statuses {
+		_, err := testQueries.CreatePost(context.Background(), CreatePostParams{
+			Title:       utils.RandomString(7),
+			Description: utils.RandomString(8),
+			Content:     utils.RandomString(9),
+			AuthorID:    int32(user.ID),
+			CategoryID:  int32(category.ID),
+			Image:       "test.jpg",
+			Status:      status,
+		})
+		require.NoError(t, err)
+	}
+
+	posts, err := testQueries.ListOwnPosts(context.Background(), ListOwnPostsParams{
+		AuthorID:   int32(user.ID),
+		PageLimit:  10,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, len(statuses))
+
+	drafts, err := testQueries.ListOwnPosts(context.Background(), ListOwnPostsParams{
+		AuthorID:   int32(user.ID),
+		Status:     NullPostStatus{PostStatus: PostStatusDraft, Valid: true},
+		PageLimit:  10,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, drafts, 2)
+	for _, post := range drafts {
+		require.Equal(t, PostStatusDraft, post.Status)
+		require.Equal(t, category.Name, post.CategoryName)
+	}
+}
+
+// TestQueries_UpdatePostStatus tests the update post status function
+func TestQueries_UpdatePostStatus(t *testing.T) {
+	post := createRandomPost(t)
+	publishAt := time.Now().Add(time.Hour)
+
+	updatedPost, err := testQueries.UpdatePostStatus(context.Background(), UpdatePostStatusParams{
+		ID:        post.ID,
+		Status:    PostStatusScheduled,
+		PublishAt: sql.NullTime{Time: publishAt, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, post.ID, updatedPost.ID)
+	require.Equal(t, PostStatusScheduled, updatedPost.Status)
+	require.True(t, updatedPost.PublishAt.Valid)
+	require.WithinDuration(t, publishAt, updatedPost.PublishAt.Time, time.Second)
+
+	// scheduled posts require the publish date
+	_, err = testQueries.UpdatePostStatus(context.Background(), UpdatePostStatusParams{
+		ID:     post.ID,
+		Status: PostStatusScheduled,
+	})
+	require.Error(t, err)
+}
+
+// TestQueries_PublishScheduledPosts tests the publish scheduled posts function
+func TestQueries_PublishScheduledPosts(t *testing.T) {
+	due := createRandomPost(t)
+	notDue := createRandomPost(t)
+
+	_, err := testQueries.UpdatePostStatus(context.Background(), UpdatePostStatusParams{
+		ID:        due.ID,
+		Status:    PostStatusScheduled,
+		PublishAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+	})
+	require.NoError(t, err)
+	_, err = testQueries.UpdatePostStatus(context.Background(), UpdatePostStatusParams{
+		ID:        notDue.ID,
+		Status:    PostStatusScheduled,
+		PublishAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	ids, err := testQueries.PublishScheduledPosts(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, ids, due.ID)
+	require.NotContains(t, ids, notDue.ID)
+
+	post, err := testQueries.GetPostByID(context.Background(), due.ID)
+	require.NoError(t, err)
+	require.Equal(t, PostStatusPublished, post.Status)
+
+	post, err = testQueries.GetPostByID(context.Background(), notDue.ID)
+	require.NoError(t, err)
+	require.Equal(t, PostStatusScheduled, post.Status)
 }
@@ -6,6 +6,8 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,30 +15,83 @@ import (
 type Querier interface {
 	AddMultipleTagsToPost(ctx context.Context, arg AddMultipleTagsToPostParams) error
 	AddTagToPost(ctx context.Context, arg AddTagToPostParams) error
+	BlockAllSessions(ctx context.Context, email string) error
+	// blocks all session families of the user except the current one,
+	// returns the number of blocked sessions
+	BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error)
+	// blocks the whole family of the session
+	BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error
+	CountCommentReplies(ctx context.Context, parentID sql.NullInt64) (int64, error)
+	CountPostRevisions(ctx context.Context, postID int64) (int64, error)
+	CountSearchPosts(ctx context.Context, query string) (int64, error)
 	CreateCategory(ctx context.Context, name string) (Category, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	CreateOIDCState(ctx context.Context, arg CreateOIDCStateParams) (OIDCState, error)
+	CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) (OutboxMessage, error)
 	CreatePost(ctx context.Context, arg CreatePostParams) (Post, error)
+	CreatePostRevision(ctx context.Context, arg CreatePostRevisionParams) (PostRevision, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (RecoveryCode, error)
+	CreateResetPassword(ctx context.Context, arg CreateResetPasswordParams) (ResetPassword, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTag(ctx context.Context, name string) (Tag, error)
+	CreateTwoFactorChallenge(ctx context.Context, arg CreateTwoFactorChallengeParams) (TwoFactorChallenge, error)
+	CreateTwoFactorSecret(ctx context.Context, arg CreateTwoFactorSecretParams) (TwoFactorSecret, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error)
+	// creates a user with the role and the email verification status set by an admin
+	CreateUserWithRole(ctx context.Context, arg CreateUserWithRoleParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
 	DeleteCategory(ctx context.Context, name string) error
 	DeleteComment(ctx context.Context, id int64) error
+	DeleteLoginAttempt(ctx context.Context, key string) error
 	DeletePost(ctx context.Context, id int64) error
+	// deletes the messages published before the time
+	DeletePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error)
+	DeleteRecoveryCodes(ctx context.Context, email string) error
 	DeleteTag(ctx context.Context, name string) error
 	DeleteTagsFromPost(ctx context.Context, arg DeleteTagsFromPostParams) error
+	// deletes the tags that are not added to any post, returns their names
+	DeleteUnusedTags(ctx context.Context) ([]string, error)
 	DeleteUser(ctx context.Context, email string) error
+	// enables the secret after the code of the time step was accepted
+	EnableTwoFactorSecret(ctx context.Context, arg EnableTwoFactorSecretParams) (TwoFactorSecret, error)
+	GetCategoryByName(ctx context.Context, name string) (Category, error)
 	GetComment(ctx context.Context, id int64) (GetCommentRow, error)
+	GetCommenterStats(ctx context.Context, arg GetCommenterStatsParams) (GetCommenterStatsRow, error)
+	GetLoginAttempts(ctx context.Context, keys []string) ([]LoginAttempt, error)
 	GetMinimalPostData(ctx context.Context, id int64) (GetMinimalPostDataRow, error)
 	GetOrCreateCategory(ctx context.Context, name string) (int64, error)
 	GetOrCreateTags(ctx context.Context, tagNames []string) ([]int32, error)
+	GetOutboxMessage(ctx context.Context, id int64) (OutboxMessage, error)
 	GetPostByID(ctx context.Context, id int64) (GetPostByIDRow, error)
 	GetPostByTitle(ctx context.Context, title string) (GetPostByTitleRow, error)
+	GetPostForUpdate(ctx context.Context, id int64) (Post, error)
+	GetPostRevision(ctx context.Context, arg GetPostRevisionParams) (PostRevision, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTagsOfPost(ctx context.Context, postID int64) ([]Tag, error)
+	GetTwoFactorChallenge(ctx context.Context, id uuid.UUID) (TwoFactorChallenge, error)
+	GetTwoFactorSecret(ctx context.Context, email string) (TwoFactorSecret, error)
 	GetUser(ctx context.Context, email string) (User, error)
+	GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error)
+	GetUserPasswordChangedAt(ctx context.Context, email string) (time.Time, error)
+	GetUserRole(ctx context.Context, email string) (string, error)
+	// counts an attempt to use the challenge, unless it is used, expired or has no attempts left
+	IncrementTwoFactorChallengeAttempts(ctx context.Context, arg IncrementTwoFactorChallengeAttemptsParams) (TwoFactorChallenge, error)
+	InvalidateResetPasswords(ctx context.Context, email string) error
+	// lists the current (not rotated) session of every active family,
+	// created_at is the time of the login that started the family
+	ListActiveSessions(ctx context.Context, email string) ([]ListActiveSessionsRow, error)
 	ListCategories(ctx context.Context, arg ListCategoriesParams) ([]Category, error)
+	ListCommentReplies(ctx context.Context, arg ListCommentRepliesParams) ([]ListCommentRepliesRow, error)
+	ListCommentTree(ctx context.Context, arg ListCommentTreeParams) ([]ListCommentTreeRow, error)
 	ListCommentsForPost(ctx context.Context, arg ListCommentsForPostParams) ([]ListCommentsForPostRow, error)
+	ListModerationQueue(ctx context.Context, arg ListModerationQueueParams) ([]ListModerationQueueRow, error)
+	ListOwnPosts(ctx context.Context, arg ListOwnPostsParams) ([]ListOwnPostsRow, error)
+	// locks the pending messages due to be published until the end of the transaction,
+	// so concurrent relays publish different messages
+	ListPendingOutboxMessages(ctx context.Context, limit int32) ([]OutboxMessage, error)
+	ListPostRevisions(ctx context.Context, arg ListPostRevisionsParams) ([]ListPostRevisionsRow, error)
 	ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPostsRow, error)
 	ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorParams) ([]ListPostsByAuthorRow, error)
 	ListPostsByCategory(ctx context.Context, arg ListPostsByCategoryParams) ([]ListPostsByCategoryRow, error)
@@ -44,12 +99,36 @@ type Querier interface {
 	ListTagIDsByNames(ctx context.Context, tagNames []string) ([]int32, error)
 	ListTags(ctx context.Context, arg ListTagsParams) ([]Tag, error)
 	ListUsersContainingString(ctx context.Context, str string) ([]User, error)
+	LockComment(ctx context.Context, id int64) (int64, error)
+	LockLoginAttempt(ctx context.Context, arg LockLoginAttemptParams) error
+	MarkOutboxMessagePublished(ctx context.Context, id int64) error
+	// moves all posts of a category to another category, returns the number of moved posts
+	MovePostsToCategory(ctx context.Context, arg MovePostsToCategoryParams) (int64, error)
+	PublishScheduledPosts(ctx context.Context) ([]int64, error)
+	RecordFailedLoginAttempt(ctx context.Context, arg RecordFailedLoginAttemptParams) (LoginAttempt, error)
+	// retries the message after the delay or, if it has failed max_attempts times, marks it as dead
+	RecordOutboxMessageFailure(ctx context.Context, arg RecordOutboxMessageFailureParams) (OutboxMessage, error)
+	// marks the session as rotated, only if it is current and not blocked
+	ReplaceSession(ctx context.Context, arg ReplaceSessionParams) (Session, error)
+	SearchPosts(ctx context.Context, arg SearchPostsParams) ([]SearchPostsRow, error)
+	SetUserDisabled(ctx context.Context, arg SetUserDisabledParams) (User, error)
+	SoftDeleteComment(ctx context.Context, id int64) error
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error)
 	UpdateComment(ctx context.Context, arg UpdateCommentParams) (Comment, error)
+	UpdateCommentsStatus(ctx context.Context, arg UpdateCommentsStatusParams) ([]int64, error)
 	UpdatePost(ctx context.Context, arg UpdatePostParams) (Post, error)
+	UpdatePostStatus(ctx context.Context, arg UpdatePostStatusParams) (Post, error)
 	UpdateTag(ctx context.Context, arg UpdateTagParams) (Tag, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UseOIDCState(ctx context.Context, state string) (OIDCState, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (RecoveryCode, error)
+	UseResetPassword(ctx context.Context, arg UseResetPasswordParams) (ResetPassword, error)
+	UseTwoFactorChallenge(ctx context.Context, id uuid.UUID) (TwoFactorChallenge, error)
+	// records the time step of the accepted TOTP code, so the codes of the same
+	// or an earlier step are rejected and an accepted code cannot be replayed
+	UseTwoFactorStep(ctx context.Context, arg UseTwoFactorStepParams) (TwoFactorSecret, error)
 }
 
 var _ Querier = (*Queries)(nil)
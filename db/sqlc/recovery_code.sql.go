@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: recovery_code.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :one
+INSERT INTO recovery_codes
+    (email, hashed_code)
+VALUES ($1, $2)
+RETURNING id, email, hashed_code, used_at, created_at
+`
+
+type CreateRecoveryCodeParams struct {
+	Email      string `json:"email"`
+	HashedCode string `json:"hashed_code"`
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (RecoveryCode, error) {
+	row := q.db.QueryRowContext(ctx, createRecoveryCode, arg.Email, arg.HashedCode)
+	var i RecoveryCode
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.HashedCode,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM recovery_codes
+WHERE email = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, email string) error {
+	_, err := q.db.ExecContext(ctx, deleteRecoveryCodes, email)
+	return err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :one
+UPDATE recovery_codes
+SET used_at = now()
+WHERE email = $1
+  AND hashed_code = $2
+  AND used_at IS NULL
+RETURNING id, email, hashed_code, used_at, created_at
+`
+
+type UseRecoveryCodeParams struct {
+	Email      string `json:"email"`
+	HashedCode string `json:"hashed_code"`
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (RecoveryCode, error) {
+	row := q.db.QueryRowContext(ctx, useRecoveryCode, arg.Email, arg.HashedCode)
+	var i RecoveryCode
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.HashedCode,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
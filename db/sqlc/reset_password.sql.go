@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: reset_password.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createResetPassword = `-- name: CreateResetPassword :one
+INSERT INTO reset_passwords
+    (email, secret_code)
+VALUES ($1, $2)
+RETURNING id, email, secret_code, is_used, created_at, expired_at
+`
+
+type CreateResetPasswordParams struct {
+	Email      string `json:"email"`
+	SecretCode string `json:"secret_code"`
+}
+
+func (q *Queries) CreateResetPassword(ctx context.Context, arg CreateResetPasswordParams) (ResetPassword, error) {
+	row := q.db.QueryRowContext(ctx, createResetPassword, arg.Email, arg.SecretCode)
+	var i ResetPassword
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const invalidateResetPasswords = `-- name: InvalidateResetPasswords :exec
+UPDATE reset_passwords
+SET is_used = TRUE
+WHERE email = $1
+  AND is_used = FALSE
+`
+
+func (q *Queries) InvalidateResetPasswords(ctx context.Context, email string) error {
+	_, err := q.db.ExecContext(ctx, invalidateResetPasswords, email)
+	return err
+}
+
+const useResetPassword = `-- name: UseResetPassword :one
+UPDATE reset_passwords
+SET is_used = TRUE
+WHERE email = $1
+  AND secret_code = $2
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING id, email, secret_code, is_used, created_at, expired_at
+`
+
+type UseResetPasswordParams struct {
+	Email      string `json:"email"`
+	SecretCode string `json:"secret_code"`
+}
+
+func (q *Queries) UseResetPassword(ctx context.Context, arg UseResetPasswordParams) (ResetPassword, error) {
+	row := q.db.QueryRowContext(ctx, useResetPassword, arg.Email, arg.SecretCode)
+	var i ResetPassword
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
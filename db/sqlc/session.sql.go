@@ -12,6 +12,74 @@ import (
 	"github.com/google/uuid"
 )
 
+const blockAllSessions = `-- name: BlockAllSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE email = $1
+  AND is_blocked = false
+`
+
+func (q *Queries) BlockAllSessions(ctx context.Context, email string) error {
+	_, err := q.db.ExecContext(ctx, blockAllSessions, email)
+	return err
+}
+
+const blockOtherSessions = `-- name: BlockOtherSessions :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE email = $1
+  AND family_id <> $2
+  AND is_blocked = false
+`
+
+type BlockOtherSessionsParams struct {
+	Email           string    `json:"email"`
+	CurrentFamilyID uuid.UUID `json:"current_family_id"`
+}
+
+// blocks all session families of the user except the current one,
+// returns the number of blocked sessions
+func (q *Queries) BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, blockOtherSessions, arg.Email, arg.CurrentFamilyID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const blockSession = `-- name: BlockSession :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE sessions.email = $1
+  AND sessions.family_id = (SELECT s.family_id FROM sessions s WHERE s.id = $2)
+`
+
+type BlockSessionParams struct {
+	Email string    `json:"email"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// blocks the whole family of the session
+func (q *Queries) BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, blockSession, arg.Email, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const blockSessionFamily = `-- name: BlockSessionFamily :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1
+  AND is_blocked = false
+`
+
+func (q *Queries) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, blockSessionFamily, familyID)
+	return err
+}
+
 const createSession = `-- name: CreateSession :one
 INSERT INTO sessions (id,
                       email,
@@ -19,9 +87,10 @@ INSERT INTO sessions (id,
                       user_agent,
                       client_ip,
                       is_blocked,
-                      expires_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, email, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+                      expires_at,
+                      family_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, email, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, family_id, replaced_by
 `
 
 type CreateSessionParams struct {
@@ -32,6 +101,7 @@ type CreateSessionParams struct {
 	ClientIp     string    `json:"client_ip"`
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
+	FamilyID     uuid.UUID `json:"family_id"`
 }
 
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
@@ -43,6 +113,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		arg.ClientIp,
 		arg.IsBlocked,
 		arg.ExpiresAt,
+		arg.FamilyID,
 	)
 	var i Session
 	err := row.Scan(
@@ -54,12 +125,14 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.IsBlocked,
 		&i.ExpiresAt,
 		&i.CreatedAt,
+		&i.FamilyID,
+		&i.ReplacedBy,
 	)
 	return i, err
 }
 
 const getSession = `-- name: GetSession :one
-SELECT id, email, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+SELECT id, email, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, family_id, replaced_by
 FROM sessions
 WHERE id = $1
 LIMIT 1
@@ -77,6 +150,95 @@ func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 		&i.IsBlocked,
 		&i.ExpiresAt,
 		&i.CreatedAt,
+		&i.FamilyID,
+		&i.ReplacedBy,
+	)
+	return i, err
+}
+
+const listActiveSessions = `-- name: ListActiveSessions :many
+SELECT s.id,
+       s.user_agent,
+       s.client_ip,
+       s.expires_at,
+       f.created_at
+FROM sessions s
+         JOIN sessions f ON f.id = s.family_id
+WHERE s.email = $1
+  AND s.is_blocked = false
+  AND s.replaced_by IS NULL
+  AND s.expires_at > now()
+ORDER BY f.created_at DESC
+`
+
+type ListActiveSessionsRow struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIp  string    `json:"client_ip"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// lists the current (not rotated) session of every active family,
+// created_at is the time of the login that started the family
+func (q *Queries) ListActiveSessions(ctx context.Context, email string) ([]ListActiveSessionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveSessions, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveSessionsRow{}
+	for rows.Next() {
+		var i ListActiveSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceSession = `-- name: ReplaceSession :one
+UPDATE sessions
+SET replaced_by = $1::uuid
+WHERE id = $2
+  AND replaced_by IS NULL
+  AND is_blocked = false
+RETURNING id, email, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, family_id, replaced_by
+`
+
+type ReplaceSessionParams struct {
+	ReplacedBy uuid.UUID `json:"replaced_by"`
+	ID         uuid.UUID `json:"id"`
+}
+
+// marks the session as rotated, only if it is current and not blocked
+func (q *Queries) ReplaceSession(ctx context.Context, arg ReplaceSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, replaceSession, arg.ReplacedBy, arg.ID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.RefreshToken,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.IsBlocked,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.FamilyID,
+		&i.ReplacedBy,
 	)
 	return i, err
 }
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// createRandomSession creates and returns a random session of the user
+func createRandomSession(t *testing.T, user User) Session {
+	id := uuid.New()
+	params := CreateSessionParams{
+		ID:           id,
+		Email:        user.Email,
+		RefreshToken: utils.RandomString(32),
+		UserAgent:    utils.RandomString(8),
+		ClientIp:     "127.0.0.1",
+		IsBlocked:    false,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+
+	session, err := testQueries.CreateSession(context.Background(), params)
+	require.NoError(t, err)
+	require.NotEmpty(t, session)
+
+	require.Equal(t, params.ID, session.ID)
+	require.Equal(t, params.Email, session.Email)
+	require.Equal(t, params.RefreshToken, session.RefreshToken)
+	require.Equal(t, params.FamilyID, session.FamilyID)
+	require.False(t, session.IsBlocked)
+	require.False(t, session.ReplacedBy.Valid)
+	require.NotZero(t, session.CreatedAt)
+
+	return session
+}
+
+// TestQueries_CreateSession tests the create session function
+func TestQueries_CreateSession(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomSession(t, user)
+}
+
+// TestQueries_GetSession tests the get session function
+func TestQueries_GetSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	session2, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, session.ID, session2.ID)
+	require.Equal(t, session.Email, session2.Email)
+	require.Equal(t, session.RefreshToken, session2.RefreshToken)
+	require.WithinDuration(t, session.ExpiresAt, session2.ExpiresAt, time.Second)
+}
+
+// TestQueries_ListActiveSessions tests the list active sessions function
+func TestQueries_ListActiveSessions(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user)
+	}
+
+	blocked := createRandomSession(t, user)
+	_, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:    blocked.ID,
+		Email: user.Email,
+	})
+	require.NoError(t, err)
+
+	sessions, err := testQueries.ListActiveSessions(context.Background(), user.Email)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	for _, session := range sessions {
+		require.NotEqual(t, blocked.ID, session.ID)
+		require.NotEmpty(t, session.UserAgent)
+	}
+}
+
+// TestQueries_BlockSession tests the block session function
+func TestQueries_BlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	// a session of another user cannot be blocked
+	otherUser := createRandomUser(t)
+	blocked, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:    session.ID,
+		Email: otherUser.Email,
+	})
+	require.NoError(t, err)
+	require.Zero(t, blocked)
+
+	blocked, err = testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:    session.ID,
+		Email: user.Email,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), blocked)
+
+	session, err = testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+}
+
+// TestQueries_ReplaceSession tests that a session can be replaced only once
+func TestQueries_ReplaceSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+	newSession := createRandomSession(t, user)
+
+	params := ReplaceSessionParams{
+		ReplacedBy: newSession.ID,
+		ID:         session.ID,
+	}
+	replaced, err := testQueries.ReplaceSession(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: newSession.ID, Valid: true}, replaced.ReplacedBy)
+
+	_, err = testQueries.ReplaceSession(context.Background(), params)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// TestQueries_BlockOtherSessions tests the block other sessions function
+func TestQueries_BlockOtherSessions(t *testing.T) {
+	user := createRandomUser(t)
+	current := createRandomSession(t, user)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user)
+	}
+
+	blockedCount, err := testQueries.BlockOtherSessions(context.Background(), BlockOtherSessionsParams{
+		Email:           user.Email,
+		CurrentFamilyID: current.FamilyID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), blockedCount)
+
+	sessions, err := testQueries.ListActiveSessions(context.Background(), user.Email)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, current.ID, sessions[0].ID)
+}
@@ -14,6 +14,16 @@ type Store interface {
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	ExecTx(ctx context.Context, fn func(*Queries) error) error
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	UpdatePostTx(ctx context.Context, arg UpdatePostTxParams) (UpdatePostTxResult, error)
+	RestorePostRevisionTx(ctx context.Context, arg RestorePostRevisionTxParams) (RestorePostRevisionTxResult, error)
+	DeleteCommentTx(ctx context.Context, commentID int64) (DeleteCommentTxResult, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error)
+	EnrollTwoFactorTx(ctx context.Context, arg EnrollTwoFactorTxParams) (EnrollTwoFactorTxResult, error)
+	OIDCLoginTx(ctx context.Context, arg OIDCLoginTxParams) (OIDCLoginTxResult, error)
+	DisableUserTx(ctx context.Context, arg DisableUserTxParams) (DisableUserTxResult, error)
+	MergeCategoriesTx(ctx context.Context, arg MergeCategoriesTxParams) (MergeCategoriesTxResult, error)
+	RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error)
 }
 
 // SQLStore provides all functions to execute db queries and transactions
@@ -26,7 +36,7 @@ type SQLStore struct {
 func NewStore(db *sql.DB) Store {
 	return &SQLStore{
 		db:      db,
-		Queries: New(db),
+		Queries: New(newTracedDBTX(db, nil)),
 	}
 }
 
@@ -37,8 +47,12 @@ type AddTagsToPostParams struct {
 
 // AddTagsToPost creates tags (if they don't exist) and creates post_tags table (many to many)
 func (store SQLStore) AddTagsToPost(ctx context.Context, arg AddTagsToPostParams) error {
+	return addTagsToPost(ctx, store.Queries, arg)
+}
+
+func addTagsToPost(ctx context.Context, q *Queries, arg AddTagsToPostParams) error {
 	// get tag ids
-	tagIDs, err := store.GetOrCreateTags(ctx, arg.Tags)
+	tagIDs, err := q.GetOrCreateTags(ctx, arg.Tags)
 	if err != nil {
 		return err
 	}
@@ -49,7 +63,7 @@ func (store SQLStore) AddTagsToPost(ctx context.Context, arg AddTagsToPostParams
 	}
 
 	// add all tags to the post
-	err = store.AddMultipleTagsToPost(ctx, params)
+	err = q.AddMultipleTagsToPost(ctx, params)
 	if err != nil {
 		return err
 	}
@@ -88,7 +102,11 @@ type RemoveTagsFromPostParams struct {
 // RemoveTagsFromPost removes given tags from a post. For each tag, checks if it's used by a different post, if not - deletes the tag and post_tags table,
 // if it is used be another post - just deletes the post_tags table
 func (store SQLStore) RemoveTagsFromPost(ctx context.Context, arg RemoveTagsFromPostParams) error {
-	tagIDs, err := store.ListTagIDsByNames(ctx, arg.Tags)
+	return removeTagsFromPost(ctx, store.Queries, arg)
+}
+
+func removeTagsFromPost(ctx context.Context, q *Queries, arg RemoveTagsFromPostParams) error {
+	tagIDs, err := q.ListTagIDsByNames(ctx, arg.Tags)
 	if err != nil {
 		return err
 	}
@@ -98,7 +116,7 @@ func (store SQLStore) RemoveTagsFromPost(ctx context.Context, arg RemoveTagsFrom
 		TagIds: tagIDs,
 	}
 
-	err = store.DeleteTagsFromPost(ctx, params)
+	err = q.DeleteTagsFromPost(ctx, params)
 	if err != nil {
 		return err
 	}
@@ -113,14 +131,18 @@ func getTagIDs(tags []Tag) []int32 {
 	return tagIDs
 }
 
-// ExecTx executes a function within a database transaction
-func (store SQLStore) ExecTx(ctx context.Context, fn func(*Queries) error) error {
+// ExecTx executes a function within a database transaction.
+// The queries of the transaction are traced as the children of its span.
+func (store SQLStore) ExecTx(ctx context.Context, fn func(*Queries) error) (err error) {
+	ctx, span := startTxSpan(ctx)
+	defer func() { endSpan(span, err) }()
+
 	tx, err := store.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	q := New(tx)
+	q := New(newTracedDBTX(tx, span))
 	err = fn(q)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
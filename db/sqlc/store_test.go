@@ -2,8 +2,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"github.com/aalug/blog-go/utils"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 // TestSQLStore_AddAndRemoveTagsToPost tests the AddTagsToPost
@@ -45,3 +50,556 @@ func TestSQLStore_AddAndRemoveTagsToPost(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(postTags))
 }
+
+// TestSQLStore_UpdatePostTx tests the UpdatePostTx and the RestorePostRevisionTx methods
+func TestSQLStore_UpdatePostTx(t *testing.T) {
+	post := createRandomPost(t)
+	editor := createRandomUser(t)
+
+	result, err := testStore.UpdatePostTx(context.Background(), UpdatePostTxParams{
+		UpdatePostParams: UpdatePostParams{
+			ID:          post.ID,
+			Title:       "new title",
+			Description: post.Description,
+			Content:     "new content",
+			CategoryID:  post.CategoryID,
+			Image:       post.Image,
+			UpdatedAt:   time.Now(),
+		},
+		EditorID: int32(editor.ID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "new title", result.Post.Title)
+	require.Equal(t, "new content", result.Post.Content)
+
+	// the original version is recorded before the first update
+	require.Equal(t, int32(2), result.Revision.Revision)
+	require.Equal(t, int32(editor.ID), result.Revision.EditorID)
+	require.Equal(t, result.Post.Title, result.Revision.Title)
+
+	original, err := testQueries.GetPostRevision(context.Background(), GetPostRevisionParams{
+		PostID:   post.ID,
+		Revision: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, post.Title, original.Title)
+	require.Equal(t, post.Content, original.Content)
+	require.Equal(t, post.AuthorID, original.EditorID)
+
+	// the status and the tags are changed together with the post
+	result, err = testStore.UpdatePostTx(context.Background(), UpdatePostTxParams{
+		UpdatePostParams: UpdatePostParams{
+			ID:          post.ID,
+			Title:       result.Post.Title,
+			Description: result.Post.Description,
+			Content:     result.Post.Content,
+			CategoryID:  post.CategoryID,
+			Image:       post.Image,
+			UpdatedAt:   time.Now(),
+		},
+		EditorID: int32(editor.ID),
+		Status:   NullPostStatus{PostStatus: PostStatusArchived, Valid: true},
+		Tags:     []string{"tag1", "tag2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, PostStatusArchived, result.Post.Status)
+	require.Len(t, result.Tags, 2)
+
+	// a failed status change rolls back the tags
+	_, err = testStore.UpdatePostTx(context.Background(), UpdatePostTxParams{
+		UpdatePostParams: UpdatePostParams{
+			ID:          post.ID,
+			Title:       result.Post.Title,
+			Description: result.Post.Description,
+			Content:     result.Post.Content,
+			CategoryID:  post.CategoryID,
+			Image:       post.Image,
+			UpdatedAt:   time.Now(),
+		},
+		EditorID: int32(editor.ID),
+		Status:   NullPostStatus{PostStatus: "invalid", Valid: true},
+		Tags:     []string{"tag3"},
+	})
+	require.Error(t, err)
+
+	tags, err := testQueries.GetTagsOfPost(context.Background(), post.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+
+	// restore the original version as a new revision
+	restored, err := testStore.RestorePostRevisionTx(context.Background(), RestorePostRevisionTxParams{
+		PostID:   post.ID,
+		Revision: 1,
+		EditorID: int32(editor.ID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, post.Title, restored.Post.Title)
+	require.Equal(t, post.Content, restored.Post.Content)
+	require.Equal(t, int32(4), restored.Revision.Revision)
+	require.True(t, restored.Revision.RestoredFrom.Valid)
+	require.Equal(t, int32(1), restored.Revision.RestoredFrom.Int32)
+
+	count, err := testQueries.CountPostRevisions(context.Background(), post.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), count)
+
+	// restoring a revision that does not exist changes nothing
+	_, err = testStore.RestorePostRevisionTx(context.Background(), RestorePostRevisionTxParams{
+		PostID:   post.ID,
+		Revision: 99,
+		EditorID: int32(editor.ID),
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestSQLStore_DeleteCommentTx tests that comments with replies are replaced
+// with a placeholder and the comments without replies are removed
+func TestSQLStore_DeleteCommentTx(t *testing.T) {
+	comment := createRandomComment(t)
+	reply := createRandomReply(t, comment)
+
+	result, err := testStore.DeleteCommentTx(context.Background(), comment.ID)
+	require.NoError(t, err)
+	require.True(t, result.SoftDeleted)
+
+	deleted, err := testQueries.GetComment(context.Background(), comment.ID)
+	require.NoError(t, err)
+	require.True(t, deleted.IsDeleted)
+
+	result, err = testStore.DeleteCommentTx(context.Background(), reply.ID)
+	require.NoError(t, err)
+	require.False(t, result.SoftDeleted)
+
+	_, err = testQueries.GetComment(context.Background(), reply.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestSQLStore_ResetPasswordTx tests that the reset code can be used only once
+// and that a successful reset invalidates the other codes and the sessions of the user
+func TestSQLStore_ResetPasswordTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	resetPassword, err := testQueries.CreateResetPassword(context.Background(), CreateResetPasswordParams{
+		Email:      user.Email,
+		SecretCode: utils.HashResetPasswordCode(utils.RandomString(32)),
+	})
+	require.NoError(t, err)
+	otherResetPassword, err := testQueries.CreateResetPassword(context.Background(), CreateResetPasswordParams{
+		Email:      user.Email,
+		SecretCode: utils.HashResetPasswordCode(utils.RandomString(32)),
+	})
+	require.NoError(t, err)
+
+	params := ResetPasswordTxParams{
+		Email:          user.Email,
+		SecretCode:     resetPassword.SecretCode,
+		HashedPassword: utils.RandomString(10),
+	}
+	result, err := testStore.ResetPasswordTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, params.HashedPassword, result.User.HashedPassword)
+	require.False(t, result.User.PasswordChangedAt.IsZero())
+
+	blockedSession, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blockedSession.IsBlocked)
+
+	// both the used and the other codes cannot be used again
+	for _, code := range []string{resetPassword.SecretCode, otherResetPassword.SecretCode} {
+		params.SecretCode = code
+		_, err = testStore.ResetPasswordTx(context.Background(), params)
+		require.EqualError(t, err, sql.ErrNoRows.Error())
+	}
+}
+
+// TestSQLStore_RotateSessionTx tests that a session can be rotated only once
+// and that blocking any session of the family blocks the whole family
+func TestSQLStore_RotateSessionTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	newSessionParams := func() CreateSessionParams {
+		return CreateSessionParams{
+			ID:           uuid.New(),
+			Email:        user.Email,
+			RefreshToken: utils.RandomString(32),
+			UserAgent:    utils.RandomString(8),
+			ClientIp:     "127.0.0.1",
+			ExpiresAt:    session.ExpiresAt,
+			FamilyID:     session.FamilyID,
+		}
+	}
+
+	result, err := testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		ID:         session.ID,
+		NewSession: newSessionParams(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, session.FamilyID, result.Session.FamilyID)
+
+	rotated, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: result.Session.ID, Valid: true}, rotated.ReplacedBy)
+
+	// only the newest session of the family is listed
+	sessions, err := testQueries.ListActiveSessions(context.Background(), user.Email)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, result.Session.ID, sessions[0].ID)
+	require.WithinDuration(t, session.CreatedAt, sessions[0].CreatedAt, time.Second)
+
+	// the rotated session cannot be rotated again and the new session is not created
+	params := newSessionParams()
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		ID:         session.ID,
+		NewSession: params,
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	_, err = testQueries.GetSession(context.Background(), params.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = testQueries.BlockSessionFamily(context.Background(), session.FamilyID)
+	require.NoError(t, err)
+
+	newSession, err := testQueries.GetSession(context.Background(), result.Session.ID)
+	require.NoError(t, err)
+	require.True(t, newSession.IsBlocked)
+}
+
+// TestSQLStore_EnrollTwoFactorTx tests that the secret and the recovery codes are replaced
+// until the two-factor authentication is enabled and that recovery codes are single-use
+func TestSQLStore_EnrollTwoFactorTx(t *testing.T) {
+	user := createRandomUser(t)
+
+	params := EnrollTwoFactorTxParams{
+		Email:               user.Email,
+		Secret:              utils.RandomString(32),
+		HashedRecoveryCodes: []string{utils.RandomString(64), utils.RandomString(64)},
+	}
+	result, err := testStore.EnrollTwoFactorTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, params.Secret, result.TwoFactorSecret.Secret)
+	require.False(t, result.TwoFactorSecret.IsEnabled)
+
+	// enrolling again replaces the secret and the recovery codes
+	oldRecoveryCode := params.HashedRecoveryCodes[0]
+	params.Secret = utils.RandomString(32)
+	params.HashedRecoveryCodes = []string{utils.RandomString(64)}
+	result, err = testStore.EnrollTwoFactorTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, params.Secret, result.TwoFactorSecret.Secret)
+
+	_, err = testQueries.UseRecoveryCode(context.Background(), UseRecoveryCodeParams{
+		Email:      user.Email,
+		HashedCode: oldRecoveryCode,
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	twoFactorSecret, err := testQueries.EnableTwoFactorSecret(context.Background(), EnableTwoFactorSecretParams{
+		Email:        user.Email,
+		LastUsedStep: 10,
+	})
+	require.NoError(t, err)
+	require.True(t, twoFactorSecret.IsEnabled)
+	require.True(t, twoFactorSecret.EnabledAt.Valid)
+	require.Equal(t, int64(10), twoFactorSecret.LastUsedStep)
+
+	// a TOTP step can be used only once and only if it is newer than the last used one
+	for _, step := range []int64{9, 10} {
+		_, err = testQueries.UseTwoFactorStep(context.Background(), UseTwoFactorStepParams{
+			Email: user.Email,
+			Step:  step,
+		})
+		require.EqualError(t, err, sql.ErrNoRows.Error())
+	}
+	twoFactorSecret, err = testQueries.UseTwoFactorStep(context.Background(), UseTwoFactorStepParams{
+		Email: user.Email,
+		Step:  11,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(11), twoFactorSecret.LastUsedStep)
+
+	// once enabled, the secret cannot be replaced
+	_, err = testStore.EnrollTwoFactorTx(context.Background(), params)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	useParams := UseRecoveryCodeParams{
+		Email:      user.Email,
+		HashedCode: params.HashedRecoveryCodes[0],
+	}
+	recoveryCode, err := testQueries.UseRecoveryCode(context.Background(), useParams)
+	require.NoError(t, err)
+	require.True(t, recoveryCode.UsedAt.Valid)
+
+	_, err = testQueries.UseRecoveryCode(context.Background(), useParams)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestSQLStore_OIDCLoginTx tests that a new identity is linked to the user with the same email
+// or to a new user and that a linked identity logs in as the same user
+func TestSQLStore_OIDCLoginTx(t *testing.T) {
+	user := createRandomUser(t)
+	require.False(t, user.IsEmailVerified)
+
+	params := OIDCLoginTxParams{
+		Issuer:         "https://" + utils.RandomString(8) + ".com",
+		Subject:        utils.RandomString(12),
+		Email:          user.Email,
+		Username:       utils.RandomString(8),
+		HashedPassword: utils.RandomString(60),
+	}
+
+	// links the identity to the existing user
+	result, err := testStore.OIDCLoginTx(context.Background(), params)
+	require.NoError(t, err)
+	require.False(t, result.IsNewUser)
+	require.Equal(t, user.Username, result.User.Username)
+	require.True(t, result.User.IsEmailVerified)
+	require.Equal(t, user.Email, result.UserIdentity.Email)
+	require.Equal(t, params.Subject, result.UserIdentity.Subject)
+
+	// a linked identity logs in as the same user, even with a different email
+	params.Email = utils.RandomEmail()
+	result, err = testStore.OIDCLoginTx(context.Background(), params)
+	require.NoError(t, err)
+	require.False(t, result.IsNewUser)
+	require.Equal(t, user.Email, result.User.Email)
+
+	// creates a new user for a new identity with an unknown email
+	params.Subject = utils.RandomString(12)
+	result, err = testStore.OIDCLoginTx(context.Background(), params)
+	require.NoError(t, err)
+	require.True(t, result.IsNewUser)
+	require.Equal(t, params.Email, result.User.Email)
+	require.Equal(t, params.Username, result.User.Username)
+	require.Equal(t, params.HashedPassword, result.User.HashedPassword)
+	require.True(t, result.User.IsEmailVerified)
+}
+
+// TestSQLStore_DisableUserTx tests that disabling a user blocks all the sessions of the user
+func TestSQLStore_DisableUserTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	result, err := testStore.DisableUserTx(context.Background(), DisableUserTxParams{Email: user.Email})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, result.User.ID)
+	require.True(t, result.User.IsDisabled)
+
+	blockedSession, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blockedSession.IsBlocked)
+
+	_, err = testStore.DisableUserTx(context.Background(), DisableUserTxParams{Email: utils.RandomEmail()})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestSQLStore_MergeCategoriesTx tests that the posts are moved to the other category
+// and the merged category is deleted
+func TestSQLStore_MergeCategoriesTx(t *testing.T) {
+	post := createRandomPost(t)
+	into := createRandomCategory(t)
+
+	postRow, err := testQueries.GetPostByID(context.Background(), post.ID)
+	require.NoError(t, err)
+	from, err := testQueries.GetCategoryByName(context.Background(), postRow.CategoryName)
+	require.NoError(t, err)
+	require.Equal(t, int64(post.CategoryID), from.ID)
+
+	_, err = testStore.MergeCategoriesTx(context.Background(), MergeCategoriesTxParams{
+		FromName: from.Name,
+		IntoName: from.Name,
+	})
+	require.ErrorIs(t, err, ErrSameCategory)
+
+	result, err := testStore.MergeCategoriesTx(context.Background(), MergeCategoriesTxParams{
+		FromName: from.Name,
+		IntoName: into.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, into.ID, result.Category.ID)
+	require.Equal(t, int64(1), result.MovedPosts)
+
+	movedPost, err := testQueries.GetPostForUpdate(context.Background(), post.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(into.ID), movedPost.CategoryID)
+
+	_, err = testQueries.GetCategoryByName(context.Background(), from.Name)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestSQLStore_RelayOutboxTx tests that the published messages are marked as published
+// and that a failed message records the error and is retried after the delay
+func TestSQLStore_RelayOutboxTx(t *testing.T) {
+	message := createRandomOutboxMessage(t)
+	failedMessage := createRandomOutboxMessage(t)
+
+	publishErr := errors.New("broker is down")
+	var published []int64
+	result, err := testStore.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+		Limit:       1000,
+		MaxAttempts: 10,
+		RetryDelay: func(attempts int32) time.Duration {
+			require.Equal(t, int32(1), attempts)
+			return time.Hour
+		},
+		Publish: func(m OutboxMessage) error {
+			if m.ID == failedMessage.ID {
+				return publishErr
+			}
+			published = append(published, m.ID)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, result.Err, publishErr)
+	require.Contains(t, published, message.ID)
+	require.Equal(t, len(published), result.Published)
+	require.Equal(t, 1, result.Failed)
+	require.Zero(t, result.Dead)
+
+	publishedMessage, err := testQueries.GetOutboxMessage(context.Background(), message.ID)
+	require.NoError(t, err)
+	require.True(t, publishedMessage.PublishedAt.Valid)
+	require.Equal(t, int32(1), publishedMessage.Attempts)
+
+	pendingMessage, err := testQueries.GetOutboxMessage(context.Background(), failedMessage.ID)
+	require.NoError(t, err)
+	require.False(t, pendingMessage.PublishedAt.Valid)
+	require.False(t, pendingMessage.DeadAt.Valid)
+	require.Equal(t, int32(1), pendingMessage.Attempts)
+	require.Equal(t, publishErr.Error(), pendingMessage.LastError)
+	require.WithinDuration(t, time.Now().Add(time.Hour), pendingMessage.NextAttemptAt, time.Minute)
+
+	// the failed message is not retried before the delay
+	result, err = testStore.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+		Limit:       1000,
+		MaxAttempts: 10,
+		RetryDelay:  func(int32) time.Duration { return 0 },
+		Publish: func(m OutboxMessage) error {
+			require.NotEqual(t, failedMessage.ID, m.ID)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, result.Err)
+
+	pendingMessage, err = testQueries.GetOutboxMessage(context.Background(), failedMessage.ID)
+	require.NoError(t, err)
+	require.False(t, pendingMessage.PublishedAt.Valid)
+}
+
+// TestSQLStore_RelayOutboxTxPoisonMessage tests that a message that always fails does not block
+// the messages after it and is dead after MaxAttempts
+func TestSQLStore_RelayOutboxTxPoisonMessage(t *testing.T) {
+	poisonMessage := createRandomOutboxMessage(t)
+	healthyMessage := createRandomOutboxMessage(t)
+
+	publishErr := errors.New("invalid payload")
+	var poisonAttempts int
+	relay := func() RelayOutboxTxResult {
+		result, err := testStore.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+			Limit:       1000,
+			MaxAttempts: 2,
+			RetryDelay:  func(int32) time.Duration { return 0 },
+			Publish: func(m OutboxMessage) error {
+				if m.ID == poisonMessage.ID {
+					poisonAttempts++
+					return publishErr
+				}
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := relay()
+	require.ErrorIs(t, result.Err, publishErr)
+	require.Equal(t, 1, result.Failed)
+	require.Zero(t, result.Dead)
+
+	// the message after the failed one is published
+	publishedMessage, err := testQueries.GetOutboxMessage(context.Background(), healthyMessage.ID)
+	require.NoError(t, err)
+	require.True(t, publishedMessage.PublishedAt.Valid)
+
+	result = relay()
+	require.ErrorIs(t, result.Err, publishErr)
+	require.Equal(t, 1, result.Dead)
+
+	deadMessage, err := testQueries.GetOutboxMessage(context.Background(), poisonMessage.ID)
+	require.NoError(t, err)
+	require.False(t, deadMessage.PublishedAt.Valid)
+	require.True(t, deadMessage.DeadAt.Valid)
+	require.Equal(t, int32(2), deadMessage.Attempts)
+	require.Equal(t, publishErr.Error(), deadMessage.LastError)
+
+	// the dead message is no longer published
+	result = relay()
+	require.NoError(t, result.Err)
+	require.Equal(t, 2, poisonAttempts)
+}
+
+// TestSQLStore_CreateUserTx tests that the outbox messages are written with the user
+// and that no message is written if the user is not created
+func TestSQLStore_CreateUserTx(t *testing.T) {
+	params := CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       utils.RandomString(6),
+			HashedPassword: utils.RandomString(10),
+			Email:          utils.RandomEmail(),
+		},
+	}
+
+	var messageType string
+	params.AfterCreate = func(user User) ([]CreateOutboxMessageParams, error) {
+		messageType = "task:" + utils.RandomString(6)
+		return []CreateOutboxMessageParams{{
+			TaskType:  messageType,
+			Payload:   []byte(`{"email":"` + user.Email + `"}`),
+			Queue:     "critical",
+			MaxRetry:  10,
+			ProcessAt: time.Now(),
+		}}, nil
+	}
+
+	result, err := testStore.CreateUserTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, params.Email, result.User.Email)
+
+	require.Equal(t, 1, countPendingOutboxMessages(t, messageType))
+
+	// the same email, so the user is not created and neither is the message
+	_, err = testStore.CreateUserTx(context.Background(), params)
+	require.Error(t, err)
+	require.Equal(t, 1, countPendingOutboxMessages(t, messageType))
+
+	// the callback is optional
+	result, err = testStore.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       utils.RandomString(6),
+			HashedPassword: utils.RandomString(10),
+			Email:          utils.RandomEmail(),
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.User.ID)
+}
+
+// countPendingOutboxMessages returns the number of the pending outbox messages of the task type
+func countPendingOutboxMessages(t *testing.T, taskType string) int {
+	messages, err := testQueries.ListPendingOutboxMessages(context.Background(), 1000)
+	require.NoError(t, err)
+
+	count := 0
+	for _, m := range messages {
+		if m.TaskType == taskType {
+			count++
+		}
+	}
+
+	return count
+}
@@ -36,6 +36,37 @@ func (q *Queries) DeleteTag(ctx context.Context, name string) error {
 	return err
 }
 
+const deleteUnusedTags = `-- name: DeleteUnusedTags :many
+DELETE
+FROM tags
+WHERE NOT EXISTS (SELECT 1 FROM post_tags WHERE post_tags.tag_id = tags.id)
+RETURNING name
+`
+
+// deletes the tags that are not added to any post, returns their names
+func (q *Queries) DeleteUnusedTags(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, deleteUnusedTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOrCreateTags = `-- name: GetOrCreateTags :many
 WITH input_tags AS (SELECT UNNEST($1::text[]) AS name),
      created_tags AS (
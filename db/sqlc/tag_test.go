@@ -80,3 +80,16 @@ func TestQueries_GetOrCreateTags(t *testing.T) {
 
 	require.Len(t, ids, 3)
 }
+
+func TestQueries_DeleteUnusedTags(t *testing.T) {
+	tag := createRandomTag(t)
+
+	names, err := testQueries.DeleteUnusedTags(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, names, tag.Name)
+
+	// the tag is deleted, so it is not returned again
+	names, err = testQueries.DeleteUnusedTags(context.Background())
+	require.NoError(t, err)
+	require.NotContains(t, names, tag.Name)
+}
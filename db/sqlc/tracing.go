@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"strings"
+)
+
+var tracer = otel.Tracer("github.com/aalug/blog-go/db/sqlc")
+
+// tracedDBTX is a DBTX that records a span for each query executed through it.
+// Spans are named after the sqlc queries, for example "GetUser".
+// For a transaction, parent is the span of the transaction,
+// so the spans of its queries are its children.
+type tracedDBTX struct {
+	db     DBTX
+	parent trace.Span
+}
+
+func newTracedDBTX(db DBTX, parent trace.Span) DBTX {
+	return tracedDBTX{
+		db:     db,
+		parent: parent,
+	}
+}
+
+func (t tracedDBTX) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	span := t.startSpan(ctx, query)
+	result, err := t.db.ExecContext(ctx, query, args...)
+	endSpan(span, err)
+	return result, err
+}
+
+func (t tracedDBTX) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	span := t.startSpan(ctx, query)
+	stmt, err := t.db.PrepareContext(ctx, query)
+	endSpan(span, err)
+	return stmt, err
+}
+
+func (t tracedDBTX) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	span := t.startSpan(ctx, query)
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	endSpan(span, err)
+	return rows, err
+}
+
+func (t tracedDBTX) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	span := t.startSpan(ctx, query)
+	row := t.db.QueryRowContext(ctx, query, args...)
+	endSpan(span, row.Err())
+	return row
+}
+
+func (t tracedDBTX) startSpan(ctx context.Context, query string) trace.Span {
+	if t.parent != nil {
+		ctx = trace.ContextWithSpan(ctx, t.parent)
+	}
+
+	name := queryName(query)
+	_, span := tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperation(name),
+			semconv.DBStatement(query),
+		),
+	)
+
+	return span
+}
+
+// endSpan ends the span, recording the error unless it is sql.ErrNoRows
+func endSpan(span trace.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// queryName returns the name of the sqlc query from its "-- name: <Name> :<command>" comment
+func queryName(query string) string {
+	comment, _, _ := strings.Cut(query, "\n")
+	if fields := strings.Fields(comment); len(fields) >= 3 && fields[0] == "--" && fields[1] == "name:" {
+		return fields[2]
+	}
+
+	return "query"
+}
+
+// startTxSpan starts the span of a transaction executed with ExecTx
+func startTxSpan(ctx context.Context) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "ExecTx",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+}
@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: two_factor_challenge.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createTwoFactorChallenge = `-- name: CreateTwoFactorChallenge :one
+INSERT INTO two_factor_challenges
+    (id, email)
+VALUES ($1, $2)
+RETURNING id, email, attempts, is_used, created_at, expired_at
+`
+
+type CreateTwoFactorChallengeParams struct {
+	ID    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+}
+
+func (q *Queries) CreateTwoFactorChallenge(ctx context.Context, arg CreateTwoFactorChallengeParams) (TwoFactorChallenge, error) {
+	row := q.db.QueryRowContext(ctx, createTwoFactorChallenge, arg.ID, arg.Email)
+	var i TwoFactorChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Attempts,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const getTwoFactorChallenge = `-- name: GetTwoFactorChallenge :one
+SELECT id, email, attempts, is_used, created_at, expired_at
+FROM two_factor_challenges
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetTwoFactorChallenge(ctx context.Context, id uuid.UUID) (TwoFactorChallenge, error) {
+	row := q.db.QueryRowContext(ctx, getTwoFactorChallenge, id)
+	var i TwoFactorChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Attempts,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const incrementTwoFactorChallengeAttempts = `-- name: IncrementTwoFactorChallengeAttempts :one
+UPDATE two_factor_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+  AND attempts < $2::int
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING id, email, attempts, is_used, created_at, expired_at
+`
+
+type IncrementTwoFactorChallengeAttemptsParams struct {
+	ID          uuid.UUID `json:"id"`
+	MaxAttempts int32     `json:"max_attempts"`
+}
+
+// counts an attempt to use the challenge, unless it is used, expired or has no attempts left
+func (q *Queries) IncrementTwoFactorChallengeAttempts(ctx context.Context, arg IncrementTwoFactorChallengeAttemptsParams) (TwoFactorChallenge, error) {
+	row := q.db.QueryRowContext(ctx, incrementTwoFactorChallengeAttempts, arg.ID, arg.MaxAttempts)
+	var i TwoFactorChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Attempts,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const useTwoFactorChallenge = `-- name: UseTwoFactorChallenge :one
+UPDATE two_factor_challenges
+SET is_used = TRUE
+WHERE id = $1
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING id, email, attempts, is_used, created_at, expired_at
+`
+
+func (q *Queries) UseTwoFactorChallenge(ctx context.Context, id uuid.UUID) (TwoFactorChallenge, error) {
+	row := q.db.QueryRowContext(ctx, useTwoFactorChallenge, id)
+	var i TwoFactorChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Attempts,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// TestQueries_TwoFactorChallenge tests that a challenge can be used only once
+// and that the attempts are counted up to the limit
+func TestQueries_TwoFactorChallenge(t *testing.T) {
+	user := createRandomUser(t)
+
+	challenge, err := testQueries.CreateTwoFactorChallenge(context.Background(), CreateTwoFactorChallengeParams{
+		ID:    uuid.New(),
+		Email: user.Email,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, challenge.Email)
+	require.Zero(t, challenge.Attempts)
+	require.False(t, challenge.IsUsed)
+	require.WithinDuration(t, time.Now().Add(5*time.Minute), challenge.ExpiredAt, time.Minute)
+
+	incrementParams := IncrementTwoFactorChallengeAttemptsParams{
+		ID:          challenge.ID,
+		MaxAttempts: 2,
+	}
+	for i := 1; i <= 2; i++ {
+		challenge, err = testQueries.IncrementTwoFactorChallengeAttempts(context.Background(), incrementParams)
+		require.NoError(t, err)
+		require.Equal(t, int32(i), challenge.Attempts)
+	}
+
+	// no more attempts are counted once the limit is reached
+	_, err = testQueries.IncrementTwoFactorChallengeAttempts(context.Background(), incrementParams)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	challenge, err = testQueries.GetTwoFactorChallenge(context.Background(), challenge.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), challenge.Attempts)
+
+	usedChallenge, err := testQueries.UseTwoFactorChallenge(context.Background(), challenge.ID)
+	require.NoError(t, err)
+	require.True(t, usedChallenge.IsUsed)
+
+	_, err = testQueries.UseTwoFactorChallenge(context.Background(), challenge.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
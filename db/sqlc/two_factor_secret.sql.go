@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: two_factor_secret.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createTwoFactorSecret = `-- name: CreateTwoFactorSecret :one
+INSERT INTO two_factor_secrets
+    (email, secret)
+VALUES ($1, $2)
+ON CONFLICT (email) DO UPDATE
+    SET secret     = EXCLUDED.secret,
+        created_at = now()
+WHERE two_factor_secrets.is_enabled = FALSE
+RETURNING email, secret, is_enabled, enabled_at, created_at, last_used_step
+`
+
+type CreateTwoFactorSecretParams struct {
+	Email  string `json:"email"`
+	Secret string `json:"secret"`
+}
+
+func (q *Queries) CreateTwoFactorSecret(ctx context.Context, arg CreateTwoFactorSecretParams) (TwoFactorSecret, error) {
+	row := q.db.QueryRowContext(ctx, createTwoFactorSecret, arg.Email, arg.Secret)
+	var i TwoFactorSecret
+	err := row.Scan(
+		&i.Email,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.LastUsedStep,
+	)
+	return i, err
+}
+
+const enableTwoFactorSecret = `-- name: EnableTwoFactorSecret :one
+UPDATE two_factor_secrets
+SET is_enabled     = TRUE,
+    enabled_at     = now(),
+    last_used_step = $1
+WHERE email = $2
+  AND is_enabled = FALSE
+RETURNING email, secret, is_enabled, enabled_at, created_at, last_used_step
+`
+
+type EnableTwoFactorSecretParams struct {
+	LastUsedStep int64  `json:"last_used_step"`
+	Email        string `json:"email"`
+}
+
+// enables the secret after the code of the time step was accepted
+func (q *Queries) EnableTwoFactorSecret(ctx context.Context, arg EnableTwoFactorSecretParams) (TwoFactorSecret, error) {
+	row := q.db.QueryRowContext(ctx, enableTwoFactorSecret, arg.LastUsedStep, arg.Email)
+	var i TwoFactorSecret
+	err := row.Scan(
+		&i.Email,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.LastUsedStep,
+	)
+	return i, err
+}
+
+const getTwoFactorSecret = `-- name: GetTwoFactorSecret :one
+SELECT email, secret, is_enabled, enabled_at, created_at, last_used_step
+FROM two_factor_secrets
+WHERE email = $1
+LIMIT 1
+`
+
+func (q *Queries) GetTwoFactorSecret(ctx context.Context, email string) (TwoFactorSecret, error) {
+	row := q.db.QueryRowContext(ctx, getTwoFactorSecret, email)
+	var i TwoFactorSecret
+	err := row.Scan(
+		&i.Email,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.LastUsedStep,
+	)
+	return i, err
+}
+
+const useTwoFactorStep = `-- name: UseTwoFactorStep :one
+UPDATE two_factor_secrets
+SET last_used_step = $1
+WHERE email = $2
+  AND is_enabled = TRUE
+  AND last_used_step < $1
+RETURNING email, secret, is_enabled, enabled_at, created_at, last_used_step
+`
+
+type UseTwoFactorStepParams struct {
+	Step  int64  `json:"step"`
+	Email string `json:"email"`
+}
+
+// records the time step of the accepted TOTP code, so the codes of the same
+// or an earlier step are rejected and an accepted code cannot be replayed
+func (q *Queries) UseTwoFactorStep(ctx context.Context, arg UseTwoFactorStepParams) (TwoFactorSecret, error) {
+	row := q.db.QueryRowContext(ctx, useTwoFactorStep, arg.Step, arg.Email)
+	var i TwoFactorSecret
+	err := row.Scan(
+		&i.Email,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.LastUsedStep,
+	)
+	return i, err
+}
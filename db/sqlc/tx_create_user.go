@@ -4,7 +4,10 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	// AfterCreate returns the tasks to enqueue for the created user (e.g. sending the verification email).
+	// They are written to the outbox in the same transaction, so they are enqueued only if the user is created.
+	// It is optional.
+	AfterCreate func(user User) ([]CreateOutboxMessageParams, error)
 }
 
 type CreateUserTxResult struct {
@@ -22,7 +25,23 @@ func (store SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		messages, err := arg.AfterCreate(result.User)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			_, err = q.CreateOutboxMessage(ctx, message)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 
 	return result, err
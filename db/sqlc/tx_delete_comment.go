@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type DeleteCommentTxResult struct {
+	// SoftDeleted is true if the comment had replies and was
+	// replaced with a "[deleted]" placeholder instead of being removed
+	SoftDeleted bool
+}
+
+// DeleteCommentTx deletes a comment. Comments with replies are not removed,
+// so the thread is not orphaned. Their content is replaced with
+// a "[deleted]" placeholder and they are marked as deleted.
+func (store SQLStore) DeleteCommentTx(ctx context.Context, commentID int64) (DeleteCommentTxResult, error) {
+	var result DeleteCommentTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		// the row lock makes new replies wait until the comment is deleted
+		if _, err := q.LockComment(ctx, commentID); err != nil {
+			return err
+		}
+
+		replies, err := q.CountCommentReplies(ctx, sql.NullInt64{Int64: commentID, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		if replies > 0 {
+			result.SoftDeleted = true
+			return q.SoftDeleteComment(ctx, commentID)
+		}
+
+		return q.DeleteComment(ctx, commentID)
+	})
+
+	return result, err
+}
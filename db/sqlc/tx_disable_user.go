@@ -0,0 +1,34 @@
+package db
+
+import "context"
+
+type DisableUserTxParams struct {
+	Email string
+}
+
+type DisableUserTxResult struct {
+	User User
+}
+
+// DisableUserTx disables the user and blocks all the sessions of the user,
+// so the refresh tokens cannot be used to get new access tokens.
+// Returns sql.ErrNoRows if the user does not exist.
+func (store SQLStore) DisableUserTx(ctx context.Context, arg DisableUserTxParams) (DisableUserTxResult, error) {
+	var result DisableUserTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.SetUserDisabled(ctx, SetUserDisabledParams{
+			Email:      arg.Email,
+			IsDisabled: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.BlockAllSessions(ctx, arg.Email)
+	})
+
+	return result, err
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+)
+
+type EnrollTwoFactorTxParams struct {
+	Email               string
+	Secret              string
+	HashedRecoveryCodes []string
+}
+
+type EnrollTwoFactorTxResult struct {
+	TwoFactorSecret TwoFactorSecret
+}
+
+// EnrollTwoFactorTx stores a new (not yet enabled) TOTP secret of the user
+// and replaces the recovery codes of the user with the given ones.
+// Returns sql.ErrNoRows if the two-factor authentication is already enabled.
+func (store SQLStore) EnrollTwoFactorTx(ctx context.Context, arg EnrollTwoFactorTxParams) (EnrollTwoFactorTxResult, error) {
+	var result EnrollTwoFactorTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.TwoFactorSecret, err = q.CreateTwoFactorSecret(ctx, CreateTwoFactorSecretParams{
+			Email:  arg.Email,
+			Secret: arg.Secret,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRecoveryCodes(ctx, arg.Email)
+		if err != nil {
+			return err
+		}
+
+		for _, hashedCode := range arg.HashedRecoveryCodes {
+			_, err = q.CreateRecoveryCode(ctx, CreateRecoveryCodeParams{
+				Email:      arg.Email,
+				HashedCode: hashedCode,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrSameCategory = errors.New("cannot merge a category into itself")
+
+type MergeCategoriesTxParams struct {
+	FromName string
+	IntoName string
+}
+
+type MergeCategoriesTxResult struct {
+	Category   Category
+	MovedPosts int64
+}
+
+// MergeCategoriesTx moves all posts of the category FromName to the category IntoName
+// and deletes the category FromName. Returns sql.ErrNoRows if any of the categories does not exist.
+func (store SQLStore) MergeCategoriesTx(ctx context.Context, arg MergeCategoriesTxParams) (MergeCategoriesTxResult, error) {
+	var result MergeCategoriesTxResult
+
+	if arg.FromName == arg.IntoName {
+		return result, ErrSameCategory
+	}
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		from, err := q.GetCategoryByName(ctx, arg.FromName)
+		if err != nil {
+			return err
+		}
+
+		result.Category, err = q.GetCategoryByName(ctx, arg.IntoName)
+		if err != nil {
+			return err
+		}
+
+		result.MovedPosts, err = q.MovePostsToCategory(ctx, MovePostsToCategoryParams{
+			ToCategoryID:   int32(result.Category.ID),
+			FromCategoryID: int32(from.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteCategory(ctx, from.Name)
+	})
+
+	return result, err
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type OIDCLoginTxParams struct {
+	Issuer  string
+	Subject string
+	// Email - the verified email from the identity
+	Email string
+	// Username and HashedPassword are used only if a new user is created
+	Username       string
+	HashedPassword string
+}
+
+type OIDCLoginTxResult struct {
+	User         User
+	UserIdentity UserIdentity
+	IsNewUser    bool
+}
+
+// OIDCLoginTx returns the user linked to the OIDC identity (issuer and subject).
+// An identity that is not linked yet is linked to the user with the same email,
+// or to a new user if there is none. The email of the user is marked as verified,
+// because the identity provider has verified it.
+func (store SQLStore) OIDCLoginTx(ctx context.Context, arg OIDCLoginTxParams) (OIDCLoginTxResult, error) {
+	var result OIDCLoginTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.UserIdentity, err = q.GetUserIdentity(ctx, GetUserIdentityParams{
+			Issuer:  arg.Issuer,
+			Subject: arg.Subject,
+		})
+		if err == nil {
+			result.User, err = q.GetUser(ctx, result.UserIdentity.Email)
+			return err
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		result.User, err = q.GetUser(ctx, arg.Email)
+		if err == sql.ErrNoRows {
+			result.IsNewUser = true
+			result.User, err = q.CreateUser(ctx, CreateUserParams{
+				Username:       arg.Username,
+				Email:          arg.Email,
+				HashedPassword: arg.HashedPassword,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if !result.User.IsEmailVerified {
+			result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+				Email: result.User.Email,
+				IsEmailVerified: sql.NullBool{
+					Bool:  true,
+					Valid: true,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.UserIdentity, err = q.CreateUserIdentity(ctx, CreateUserIdentityParams{
+			Email:   result.User.Email,
+			Issuer:  arg.Issuer,
+			Subject: arg.Subject,
+		})
+		return err
+	})
+
+	return result, err
+}
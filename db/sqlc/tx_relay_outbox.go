@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+type RelayOutboxTxParams struct {
+	Limit int32
+	// MaxAttempts is the number of failed attempts after which a message is dead and no longer published
+	MaxAttempts int32
+	// RetryDelay returns the delay of the next attempt to publish a message that has failed attempts times
+	RetryDelay func(attempts int32) time.Duration
+	// Publish publishes the message. A message can be published more than once
+	// (e.g. if the transaction fails to commit), so the publishing must be idempotent.
+	Publish func(message OutboxMessage) error
+}
+
+type RelayOutboxTxResult struct {
+	Published int
+	Failed    int
+	// Dead is the number of the failed messages that have reached MaxAttempts
+	Dead int
+	// Err is the error of publishing the first message that failed
+	Err error
+}
+
+// RelayOutboxTx publishes up to Limit pending outbox messages in order and marks them as published.
+// The messages stay locked until the transaction ends, so concurrent relays publish different messages.
+// A failed message records the error and is retried after RetryDelay, so it does not block the messages
+// after it. After MaxAttempts failed attempts the message is dead.
+func (store SQLStore) RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error) {
+	var result RelayOutboxTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		messages, err := q.ListPendingOutboxMessages(ctx, arg.Limit)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			publishErr := arg.Publish(message)
+			if publishErr != nil {
+				if result.Err == nil {
+					result.Err = publishErr
+				}
+
+				failedMessage, err := q.RecordOutboxMessageFailure(ctx, RecordOutboxMessageFailureParams{
+					ID:                message.ID,
+					LastError:         publishErr.Error(),
+					RetryDelaySeconds: int32(arg.RetryDelay(message.Attempts + 1).Seconds()),
+					MaxAttempts:       arg.MaxAttempts,
+				})
+				if err != nil {
+					return err
+				}
+
+				result.Failed++
+				if failedMessage.DeadAt.Valid {
+					result.Dead++
+				}
+				continue
+			}
+
+			err = q.MarkOutboxMessagePublished(ctx, message.ID)
+			if err != nil {
+				return err
+			}
+			result.Published++
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type ResetPasswordTxParams struct {
+	Email string
+	// SecretCode is the hash of the reset password code, see utils.HashResetPasswordCode
+	SecretCode     string
+	HashedPassword string
+}
+
+type ResetPasswordTxResult struct {
+	User User
+}
+
+// ResetPasswordTx uses the reset password code and sets the new password.
+// All the other reset codes and all the sessions of the user are invalidated.
+// Returns sql.ErrNoRows if the code is invalid, used or expired.
+func (store SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		_, err = q.UseResetPassword(ctx, UseResetPasswordParams{
+			Email:      arg.Email,
+			SecretCode: arg.SecretCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.InvalidateResetPasswords(ctx, arg.Email)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+			Email: arg.Email,
+			HashedPassword: sql.NullString{
+				String: arg.HashedPassword,
+				Valid:  true,
+			},
+			// truncated like the issue time of the tokens instead of rounded by the database
+			PasswordChangedAt: sql.NullTime{
+				Time:  time.Now().Truncate(time.Microsecond),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.BlockAllSessions(ctx, arg.Email)
+	})
+
+	return result, err
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type RotateSessionTxParams struct {
+	// ID of the session whose refresh token is rotated
+	ID         uuid.UUID
+	NewSession CreateSessionParams
+}
+
+type RotateSessionTxResult struct {
+	Session Session
+}
+
+// RotateSessionTx creates a new session in the family of the rotated one
+// and marks the rotated session as replaced by it. Returns sql.ErrNoRows if the session
+// has already been rotated or blocked, which means its refresh token was reused.
+func (store SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error) {
+	var result RotateSessionTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Session, err = q.CreateSession(ctx, arg.NewSession)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.ReplaceSession(ctx, ReplaceSessionParams{
+			ReplacedBy: result.Session.ID,
+			ID:         arg.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/utils"
+	"time"
+)
+
+type UpdatePostTxParams struct {
+	UpdatePostParams
+	EditorID int32
+	// Status and PublishAt are changed only if the Status is valid
+	Status    NullPostStatus
+	PublishAt sql.NullTime
+	// Tags replace the tags of the post, nil leaves them unchanged
+	Tags []string
+}
+
+type UpdatePostTxResult struct {
+	Post     Post
+	Revision PostRevision
+	Tags     []Tag
+}
+
+// UpdatePostTx updates a post, its status and tags, and records the new title,
+// description and content as a revision. Posts without any revisions (created before
+// revisions were tracked or never updated) first get their current
+// version recorded, so the history always starts with the original post.
+func (store SQLStore) UpdatePostTx(ctx context.Context, arg UpdatePostTxParams) (UpdatePostTxResult, error) {
+	var result UpdatePostTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		if _, err = lockPostAndRecordOriginal(ctx, q, arg.ID); err != nil {
+			return err
+		}
+
+		result.Post, err = q.UpdatePost(ctx, arg.UpdatePostParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.Status.Valid {
+			result.Post, err = q.UpdatePostStatus(ctx, UpdatePostStatusParams{
+				ID:        arg.ID,
+				Status:    arg.Status.PostStatus,
+				PublishAt: arg.PublishAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Revision, err = q.CreatePostRevision(ctx, CreatePostRevisionParams{
+			PostID:      result.Post.ID,
+			Title:       result.Post.Title,
+			Description: result.Post.Description,
+			Content:     result.Post.Content,
+			EditorID:    arg.EditorID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Tags != nil {
+			if err = replacePostTags(ctx, q, arg.ID, arg.Tags); err != nil {
+				return err
+			}
+		}
+
+		result.Tags, err = q.GetTagsOfPost(ctx, arg.ID)
+		return err
+	})
+
+	return result, err
+}
+
+type RestorePostRevisionTxParams struct {
+	PostID   int64
+	Revision int32
+	EditorID int32
+}
+
+type RestorePostRevisionTxResult struct {
+	Post     Post
+	Revision PostRevision
+}
+
+// RestorePostRevisionTx restores title, description and content of the post
+// from the given revision. The restored version is recorded as a new revision,
+// so the history is never rewritten.
+func (store SQLStore) RestorePostRevisionTx(ctx context.Context, arg RestorePostRevisionTxParams) (RestorePostRevisionTxResult, error) {
+	var result RestorePostRevisionTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		post, err := lockPostAndRecordOriginal(ctx, q, arg.PostID)
+		if err != nil {
+			return err
+		}
+
+		revision, err := q.GetPostRevision(ctx, GetPostRevisionParams{
+			PostID:   arg.PostID,
+			Revision: arg.Revision,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Post, err = q.UpdatePost(ctx, UpdatePostParams{
+			ID:          post.ID,
+			Title:       revision.Title,
+			Description: revision.Description,
+			Content:     revision.Content,
+			CategoryID:  post.CategoryID,
+			Image:       post.Image,
+			UpdatedAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Revision, err = q.CreatePostRevision(ctx, CreatePostRevisionParams{
+			PostID:      result.Post.ID,
+			Title:       result.Post.Title,
+			Description: result.Post.Description,
+			Content:     result.Post.Content,
+			EditorID:    arg.EditorID,
+			RestoredFrom: sql.NullInt32{
+				Int32: revision.Revision,
+				Valid: true,
+			},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// replacePostTags adds the tags that the post does not have yet
+// and removes the tags of the post that are not in the given list
+func replacePostTags(ctx context.Context, q *Queries, postID int64, tags []string) error {
+	postTags, err := q.GetTagsOfPost(ctx, postID)
+	if err != nil {
+		return err
+	}
+	postTagNames := make([]string, len(postTags))
+	for i, tag := range postTags {
+		postTagNames[i] = tag.Name
+	}
+
+	// toRemove - tags that are in post but not in the list
+	// toAdd - tags that are in the list but not in post
+	toRemove, toAdd := utils.CompareTagLists(postTagNames, tags)
+
+	if len(toAdd) > 0 {
+		err = addTagsToPost(ctx, q, AddTagsToPostParams{
+			PostID: postID,
+			Tags:   toAdd,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		return removeTagsFromPost(ctx, q, RemoveTagsFromPostParams{
+			PostID: postID,
+			Tags:   toRemove,
+		})
+	}
+	return nil
+}
+
+// lockPostAndRecordOriginal locks the post for the rest of the transaction
+// and, if it has no revisions yet, records its current version
+// as the first revision, attributed to the author.
+func lockPostAndRecordOriginal(ctx context.Context, q *Queries, postID int64) (Post, error) {
+	post, err := q.GetPostForUpdate(ctx, postID)
+	if err != nil {
+		return post, err
+	}
+
+	count, err := q.CountPostRevisions(ctx, postID)
+	if err != nil || count > 0 {
+		return post, err
+	}
+
+	_, err = q.CreatePostRevision(ctx, CreatePostRevisionParams{
+		PostID:      post.ID,
+		Title:       post.Title,
+		Description: post.Description,
+		Content:     post.Content,
+		EditorID:    post.AuthorID,
+	})
+	return post, err
+}
@@ -8,13 +8,14 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users
     (username, email, hashed_password)
 VALUES ($1, $2, $3)
-RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified
+RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
 `
 
 type CreateUserParams struct {
@@ -34,6 +35,47 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
+	)
+	return i, err
+}
+
+const createUserWithRole = `-- name: CreateUserWithRole :one
+INSERT INTO users
+    (username, email, hashed_password, role, is_email_verified)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
+`
+
+type CreateUserWithRoleParams struct {
+	Username        string   `json:"username"`
+	Email           string   `json:"email"`
+	HashedPassword  string   `json:"hashed_password"`
+	Role            UserRole `json:"role"`
+	IsEmailVerified bool     `json:"is_email_verified"`
+}
+
+// creates a user with the role and the email verification status set by an admin
+func (q *Queries) CreateUserWithRole(ctx context.Context, arg CreateUserWithRoleParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createUserWithRole,
+		arg.Username,
+		arg.Email,
+		arg.HashedPassword,
+		arg.Role,
+		arg.IsEmailVerified,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.HashedPassword,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
 	)
 	return i, err
 }
@@ -50,7 +92,7 @@ func (q *Queries) DeleteUser(ctx context.Context, email string) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, email, hashed_password, password_changed_at, created_at, is_email_verified
+SELECT id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
 FROM users
 WHERE email = $1
 LIMIT 1
@@ -67,12 +109,42 @@ func (q *Queries) GetUser(ctx context.Context, email string) (User, error) {
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
 	)
 	return i, err
 }
 
+const getUserPasswordChangedAt = `-- name: GetUserPasswordChangedAt :one
+SELECT password_changed_at
+FROM users
+WHERE email = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserPasswordChangedAt(ctx context.Context, email string) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getUserPasswordChangedAt, email)
+	var password_changed_at time.Time
+	err := row.Scan(&password_changed_at)
+	return password_changed_at, err
+}
+
+const getUserRole = `-- name: GetUserRole :one
+SELECT role::text
+FROM users
+WHERE email = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserRole(ctx context.Context, email string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserRole, email)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
 const listUsersContainingString = `-- name: ListUsersContainingString :many
-SELECT id, username, email, hashed_password, password_changed_at, created_at, is_email_verified
+SELECT id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
 FROM users
 WHERE username ILIKE '%' || $1::text || '%'
    OR email ILIKE '%' || $1::text || '%'
@@ -95,6 +167,8 @@ func (q *Queries) ListUsersContainingString(ctx context.Context, str string) ([]
 			&i.PasswordChangedAt,
 			&i.CreatedAt,
 			&i.IsEmailVerified,
+			&i.Role,
+			&i.IsDisabled,
 		); err != nil {
 			return nil, err
 		}
@@ -109,6 +183,35 @@ func (q *Queries) ListUsersContainingString(ctx context.Context, str string) ([]
 	return items, nil
 }
 
+const setUserDisabled = `-- name: SetUserDisabled :one
+UPDATE users
+SET is_disabled = $2
+WHERE email = $1
+RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
+`
+
+type SetUserDisabledParams struct {
+	Email      string `json:"email"`
+	IsDisabled bool   `json:"is_disabled"`
+}
+
+func (q *Queries) SetUserDisabled(ctx context.Context, arg SetUserDisabledParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, setUserDisabled, arg.Email, arg.IsDisabled)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.HashedPassword,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
+	)
+	return i, err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET hashed_password     = COALESCE($1, hashed_password),
@@ -116,7 +219,7 @@ SET hashed_password     = COALESCE($1, hashed_password),
     username            = COALESCE($3, username),
     is_email_verified   = COALESCE($4, is_email_verified)
 WHERE email = $5
-RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified
+RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
 `
 
 type UpdateUserParams struct {
@@ -144,6 +247,37 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
+	)
+	return i, err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE email = $1
+RETURNING id, username, email, hashed_password, password_changed_at, created_at, is_email_verified, role, is_disabled
+`
+
+type UpdateUserRoleParams struct {
+	Email string   `json:"email"`
+	Role  UserRole `json:"role"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserRole, arg.Email, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.HashedPassword,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.IsDisabled,
 	)
 	return i, err
 }
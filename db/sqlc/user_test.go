@@ -26,6 +26,7 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, params.HashedPassword, user.HashedPassword)
 	require.Equal(t, params.Email, user.Email)
 
+	require.Equal(t, UserRoleReader, user.Role)
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
 
@@ -110,3 +111,42 @@ func TestQueries_UpdateUser(t *testing.T) {
 	require.Equal(t, params.Email, updatedUser.Email)
 	require.WithinDuration(t, params.PasswordChangedAt.Time, updatedUser.PasswordChangedAt, time.Second)
 }
+
+func TestQueries_UpdateUserRole(t *testing.T) {
+	user := createRandomUser(t)
+	params := UpdateUserRoleParams{
+		Email: user.Email,
+		Role:  UserRoleModerator,
+	}
+
+	updatedUser, err := testQueries.UpdateUserRole(context.Background(), params)
+	require.NoError(t, err)
+	require.NotEmpty(t, updatedUser)
+
+	require.Equal(t, user.ID, updatedUser.ID)
+	require.Equal(t, UserRoleModerator, updatedUser.Role)
+}
+
+func TestQueries_GetUserRole(t *testing.T) {
+	user := createRandomUser(t)
+
+	role, err := testQueries.GetUserRole(context.Background(), user.Email)
+	require.NoError(t, err)
+	require.Equal(t, string(UserRoleReader), role)
+
+	_, err = testQueries.GetUserRole(context.Background(), utils.RandomEmail())
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestQueries_SetUserDisabled(t *testing.T) {
+	user := createRandomUser(t)
+	require.False(t, user.IsDisabled)
+
+	updatedUser, err := testQueries.SetUserDisabled(context.Background(), SetUserDisabledParams{
+		Email:      user.Email,
+		IsDisabled: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, updatedUser.ID)
+	require.True(t, updatedUser.IsDisabled)
+}
@@ -2,6 +2,27 @@
 -- Database: PostgreSQL
 -- Generated at: 2023-08-17T22:59:23.242Z
 
+CREATE TYPE "post_status" AS ENUM (
+  'draft',
+  'scheduled',
+  'published',
+  'archived'
+);
+
+CREATE TYPE "user_role" AS ENUM (
+  'reader',
+  'author',
+  'moderator',
+  'admin'
+);
+
+CREATE TYPE "comment_status" AS ENUM (
+  'pending',
+  'approved',
+  'rejected',
+  'spam'
+);
+
 CREATE TABLE "users" (
   "id" bigserial PRIMARY KEY,
   "username" varchar NOT NULL,
@@ -9,6 +30,8 @@ CREATE TABLE "users" (
   "hashed_password" varchar NOT NULL,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
   "is_email_verified" bool NOT NULL DEFAULT false,
+  "role" user_role NOT NULL DEFAULT 'reader',
+  "is_disabled" bool NOT NULL DEFAULT false,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -21,6 +44,79 @@ CREATE TABLE "verify_emails" (
   "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
 );
 
+CREATE TABLE "reset_passwords" (
+  "id" bigserial PRIMARY KEY,
+  "email" varchar NOT NULL,
+  "secret_code" varchar NOT NULL,
+  "is_used" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
+);
+
+CREATE TABLE "two_factor_secrets" (
+  "email" varchar PRIMARY KEY,
+  "secret" varchar NOT NULL,
+  "is_enabled" bool NOT NULL DEFAULT false,
+  "enabled_at" timestamptz,
+  "last_used_step" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "recovery_codes" (
+  "id" bigserial PRIMARY KEY,
+  "email" varchar NOT NULL,
+  "hashed_code" varchar NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "two_factor_challenges" (
+  "id" uuid PRIMARY KEY,
+  "email" varchar NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "is_used" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '5 minutes')
+);
+
+CREATE TABLE "user_identities" (
+  "id" bigserial PRIMARY KEY,
+  "email" varchar NOT NULL,
+  "issuer" varchar NOT NULL,
+  "subject" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "oidc_states" (
+  "state" varchar PRIMARY KEY,
+  "nonce" varchar NOT NULL,
+  "code_verifier" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '10 minutes')
+);
+
+CREATE TABLE "login_attempts" (
+  "key" varchar PRIMARY KEY,
+  "failed_attempts" int NOT NULL DEFAULT 0,
+  "last_failed_at" timestamptz NOT NULL DEFAULT (now()),
+  "locked_until" timestamptz
+);
+
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" int NOT NULL,
+  "process_at" timestamptz NOT NULL DEFAULT (now()),
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "published_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "dead_at" timestamptz
+);
+
 CREATE TABLE "categories" (
   "id" bigserial PRIMARY KEY,
   "name" varchar UNIQUE NOT NULL,
@@ -36,7 +132,10 @@ CREATE TABLE "posts" (
   "category_id" integer NOT NULL,
   "image" varchar NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now()),
-  "updated_at" timestamptz NOT NULL DEFAULT (now())
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "search_vector" tsvector NOT NULL,
+  "status" post_status NOT NULL DEFAULT 'published',
+  "publish_at" timestamptz
 );
 
 CREATE TABLE "tags" (
@@ -55,6 +154,22 @@ CREATE TABLE "comments" (
   "content" text NOT NULL,
   "user_id" integer NOT NULL,
   "post_id" integer NOT NULL,
+  "parent_id" bigint,
+  "is_deleted" bool NOT NULL DEFAULT false,
+  "status" comment_status NOT NULL DEFAULT 'approved',
+  "moderation_reason" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "post_revisions" (
+  "id" bigserial PRIMARY KEY,
+  "post_id" bigint NOT NULL,
+  "revision" integer NOT NULL,
+  "title" varchar NOT NULL,
+  "description" varchar NOT NULL,
+  "content" text NOT NULL,
+  "editor_id" integer NOT NULL,
+  "restored_from" integer,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -66,7 +181,9 @@ CREATE TABLE "sessions" (
   "client_ip" varchar NOT NULL,
   "is_blocked" boolean NOT NULL DEFAULT false,
   "expires_at" timestamptz NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "family_id" uuid NOT NULL,
+  "replaced_by" uuid
 );
 
 CREATE INDEX ON "users" ("email");
@@ -77,12 +194,58 @@ CREATE INDEX ON "posts" ("title");
 
 CREATE INDEX ON "posts" ("created_at");
 
+CREATE INDEX ON "posts" ("search_vector");
+
+CREATE INDEX ON "posts" ("status");
+
+CREATE INDEX ON "posts" ("publish_at");
+
 CREATE INDEX ON "tags" ("name");
 
 CREATE INDEX ON "comments" ("created_at");
 
+CREATE INDEX ON "comments" ("parent_id");
+
+CREATE INDEX ON "comments" ("status");
+
+CREATE UNIQUE INDEX ON "post_revisions" ("post_id", "revision");
+
+CREATE INDEX ON "sessions" ("email");
+
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "reset_passwords" ("email");
+
+CREATE INDEX ON "recovery_codes" ("email");
+
+CREATE UNIQUE INDEX ON "user_identities" ("issuer", "subject");
+
+CREATE INDEX ON "user_identities" ("email");
+
+CREATE INDEX ON "outbox" ("published_at");
+
+COMMENT ON COLUMN "posts"."search_vector" IS 'generated from weighted title (A), description (B) and content (C)';
+
+COMMENT ON COLUMN "posts"."publish_at" IS 'required when status is scheduled';
+
+COMMENT ON COLUMN "post_revisions"."revision" IS 'consecutive number within the post, starting at 1';
+
+COMMENT ON COLUMN "post_revisions"."restored_from" IS 'revision number this one was restored from';
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the session created at login, shared by all rotated sessions';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
 
+ALTER TABLE "reset_passwords" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "two_factor_secrets" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "recovery_codes" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "two_factor_challenges" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "user_identities" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
 ALTER TABLE "posts" ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id");
 
 ALTER TABLE "posts" ADD FOREIGN KEY ("category_id") REFERENCES "categories" ("id");
@@ -95,4 +258,12 @@ ALTER TABLE "comments" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
 
 ALTER TABLE "comments" ADD FOREIGN KEY ("post_id") REFERENCES "posts" ("id");
 
+ALTER TABLE "comments" ADD FOREIGN KEY ("parent_id") REFERENCES "comments" ("id");
+
+ALTER TABLE "post_revisions" ADD FOREIGN KEY ("post_id") REFERENCES "posts" ("id");
+
+ALTER TABLE "post_revisions" ADD FOREIGN KEY ("editor_id") REFERENCES "users" ("id");
+
 ALTER TABLE "sessions" ADD FOREIGN KEY ("email") REFERENCES "users" ("email");
+
+ALTER TABLE "sessions" ADD FOREIGN KEY ("replaced_by") REFERENCES "sessions" ("id");
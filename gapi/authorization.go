@@ -2,9 +2,15 @@ package gapi
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/utils"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"strings"
 )
 
@@ -13,33 +19,105 @@ const (
 	authorizationType   = "bearer"
 )
 
-func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+// authorizeUser verifies the access token from the request metadata and checks
+// that it was issued after the last password change of the user and that its role,
+// lowered to the current role of a demoted user, is at least requiredRole.
+// Returned errors are gRPC status errors.
+func (server *Server) authorizeUser(ctx context.Context, requiredRole string) (*token.Payload, error) {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	payload, err := server.tokenMaker.VerifyToken(accessToken)
+	if err != nil {
+		return nil, unauthenticatedError(fmt.Errorf("invalid access token: %w", err))
+	}
+
+	err = server.passwordChanges.Check(ctx, payload)
+	if err != nil {
+		if errors.Is(err, token.ErrRevokedToken) || errors.Is(err, token.ErrInvalidToken) {
+			return nil, unauthenticatedError(err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check access token: %s", err)
+	}
+
+	if !utils.HasRole(payload.Role, requiredRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %s is not allowed to perform this action", payload.Role)
+	}
+
+	return payload, nil
+}
+
+// accessTokenFromMetadata returns the bearer access token from the request metadata
+func accessTokenFromMetadata(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, fmt.Errorf("missing metadata")
+		return "", fmt.Errorf("missing metadata")
 	}
 
 	values := md.Get(authorizationHeader)
 	if len(values) == 0 {
-		return nil, fmt.Errorf("missing %s header", authorizationHeader)
+		return "", fmt.Errorf("missing %s header", authorizationHeader)
 	}
 
-	authHeader := values[0]
+	return parseAuthorizationHeader(values[0])
+}
+
+// parseAuthorizationHeader returns the access token from the value of a bearer authorization header
+func parseAuthorizationHeader(authHeader string) (string, error) {
 	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
-		return nil, fmt.Errorf("invalid %s header format", authorizationHeader)
+		return "", fmt.Errorf("invalid %s header format", authorizationHeader)
 	}
 
 	authType := strings.ToLower(fields[0])
 	if authType != authorizationType {
-		return nil, fmt.Errorf("unsupported authorization type %s", authType)
+		return "", fmt.Errorf("unsupported authorization type %s", authType)
+	}
+
+	return fields[1], nil
+}
+
+// authenticatedEmail returns the email from a valid access token in the request metadata,
+// without checking the password changes. Returns an empty string for anonymous requests.
+func (server *Server) authenticatedEmail(ctx context.Context) string {
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return ""
 	}
 
-	accessToken := fields[1]
 	payload, err := server.tokenMaker.VerifyToken(accessToken)
 	if err != nil {
-		return nil, fmt.Errorf("invalid access token: %w", err)
+		return ""
 	}
 
-	return payload, nil
+	return payload.Email
+}
+
+// authorizePostAuthor checks if the authenticated user is the author of the post.
+// Returned errors are gRPC status errors.
+func (server *Server) authorizePostAuthor(ctx context.Context, postID int64) (db.User, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return db.User{}, err
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return authUser, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	post, err := server.store.GetMinimalPostData(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return authUser, status.Errorf(codes.NotFound, "post not found")
+		}
+		return authUser, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+	if post.AuthorID != int32(authUser.ID) {
+		return authUser, status.Errorf(codes.PermissionDenied, "post does not belong to the authenticated user")
+	}
+
+	return authUser, nil
 }
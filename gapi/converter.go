@@ -1,6 +1,7 @@
 package gapi
 
 import (
+	"database/sql"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/pb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -11,7 +12,226 @@ func convertUser(user db.User) *pb.User {
 	return &pb.User{
 		Username:          user.Username,
 		Email:             user.Email,
+		Role:              string(user.Role),
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+// convertCategory converts a db.Category object to a pb.Category object
+func convertCategory(category db.Category) *pb.Category {
+	return &pb.Category{
+		Id:        category.ID,
+		Name:      category.Name,
+		CreatedAt: timestamppb.New(category.CreatedAt),
+	}
+}
+
+// convertTag converts a db.Tag object to a pb.Tag object
+func convertTag(tag db.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:   tag.ID,
+		Name: tag.Name,
+	}
+}
+
+// convertPost converts a post row and names of its tags to a pb.Post object
+func convertPost(post db.GetPostByIDRow, tags []db.Tag) *pb.Post {
+	tagNames := make([]string, len(tags))
+	for i, tag := range tags {
+		tagNames[i] = tag.Name
+	}
+
+	return &pb.Post{
+		Id:          post.ID,
+		Title:       post.Title,
+		Description: post.Description,
+		Content:     post.Content,
+		Author:      post.AuthorUsername,
+		Category:    post.CategoryName,
+		Tags:        tagNames,
+		Image:       post.Image,
+		CreatedAt:   timestamppb.New(post.CreatedAt),
+		Status:      string(post.Status),
+		PublishAt:   convertNullTime(post.PublishAt),
+	}
+}
+
+// convertPostListItem converts a row returned by one of the
+// list posts queries to a pb.PostListItem object. All the list rows
+// have the same fields, so they can be converted to db.ListPostsRow.
+func convertPostListItem(post db.ListPostsRow) *pb.PostListItem {
+	return &pb.PostListItem{
+		Id:          post.ID,
+		Title:       post.Title,
+		Description: post.Description,
+		Author:      post.AuthorUsername,
+		Category:    post.CategoryName,
+		Image:       post.Image,
+		CreatedAt:   timestamppb.New(post.CreatedAt),
+	}
+}
+
+// convertPostSearchResult converts a db.SearchPostsRow object to a pb.PostSearchResult object
+func convertPostSearchResult(post db.SearchPostsRow) *pb.PostSearchResult {
+	return &pb.PostSearchResult{
+		Id:               post.ID,
+		Title:            post.Title,
+		Description:      post.Description,
+		Author:           post.AuthorUsername,
+		Category:         post.CategoryName,
+		Image:            post.Image,
+		CreatedAt:        timestamppb.New(post.CreatedAt),
+		Rank:             post.Rank,
+		HighlightedTitle: post.HighlightedTitle,
+		Snippet:          post.Snippet,
+	}
+}
+
+// convertComment converts a db.Comment object and its author's username to a pb.Comment object
+func convertComment(comment db.Comment, username string) *pb.Comment {
+	return &pb.Comment{
+		Id:        comment.ID,
+		Content:   comment.Content,
+		UserId:    comment.UserID,
+		Username:  username,
+		PostId:    comment.PostID,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		ParentId:  convertNullInt64(comment.ParentID),
+		IsDeleted: comment.IsDeleted,
+		Status:    string(comment.Status),
+	}
+}
+
+// convertListCommentsRow converts a db.ListCommentsForPostRow object to a pb.Comment object
+func convertListCommentsRow(comment db.ListCommentsForPostRow, postID int32) *pb.Comment {
+	return &pb.Comment{
+		Id:        comment.ID,
+		Content:   comment.Content,
+		UserId:    comment.UserID,
+		Username:  comment.Username,
+		PostId:    postID,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		ParentId:  convertNullInt64(comment.ParentID),
+		IsDeleted: comment.IsDeleted,
+	}
+}
+
+// convertCommentTree converts db.ListCommentTreeRow objects to pb.Comment objects
+// with the replies nested under their parents. Rows have to be ordered by depth,
+// so every parent comes before its replies.
+func convertCommentTree(rows []db.ListCommentTreeRow, postID int32) []*pb.Comment {
+	roots := make([]*pb.Comment, 0)
+	nodes := make(map[int64]*pb.Comment, len(rows))
+
+	for _, row := range rows {
+		node := &pb.Comment{
+			Id:         row.ID,
+			Content:    row.Content,
+			UserId:     row.UserID,
+			Username:   row.Username,
+			PostId:     postID,
+			CreatedAt:  timestamppb.New(row.CreatedAt),
+			ParentId:   convertNullInt64(row.ParentID),
+			IsDeleted:  row.IsDeleted,
+			ReplyCount: row.ReplyCount,
+		}
+		nodes[row.ID] = node
+
+		if row.Depth == 0 {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[row.ParentID.Int64]; ok {
+			parent.Replies = append(parent.Replies, node)
+		}
+	}
+
+	return roots
+}
+
+// convertCommentReply converts a db.ListCommentRepliesRow object to a pb.Comment object
+func convertCommentReply(reply db.ListCommentRepliesRow) *pb.Comment {
+	return &pb.Comment{
+		Id:         reply.ID,
+		Content:    reply.Content,
+		UserId:     reply.UserID,
+		Username:   reply.Username,
+		PostId:     reply.PostID,
+		CreatedAt:  timestamppb.New(reply.CreatedAt),
+		ParentId:   convertNullInt64(reply.ParentID),
+		IsDeleted:  reply.IsDeleted,
+		ReplyCount: reply.ReplyCount,
+	}
+}
+
+// convertModerationQueueRow converts a db.ListModerationQueueRow object to a pb.Comment object
+func convertModerationQueueRow(comment db.ListModerationQueueRow) *pb.Comment {
+	return &pb.Comment{
+		Id:               comment.ID,
+		Content:          comment.Content,
+		UserId:           comment.UserID,
+		Username:         comment.Username,
+		PostId:           comment.PostID,
+		CreatedAt:        timestamppb.New(comment.CreatedAt),
+		ParentId:         convertNullInt64(comment.ParentID),
+		Status:           string(comment.Status),
+		ModerationReason: comment.ModerationReason,
+	}
+}
+
+// convertNullInt64 converts sql.NullInt64 into an optional int64
+func convertNullInt64(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
+
+// convertOwnPostListItem converts a db.ListOwnPostsRow object to a pb.OwnPostListItem object
+func convertOwnPostListItem(post db.ListOwnPostsRow) *pb.OwnPostListItem {
+	return &pb.OwnPostListItem{
+		Id:          post.ID,
+		Title:       post.Title,
+		Description: post.Description,
+		Category:    post.CategoryName,
+		Image:       post.Image,
+		Status:      string(post.Status),
+		PublishAt:   convertNullTime(post.PublishAt),
+		CreatedAt:   timestamppb.New(post.CreatedAt),
+		UpdatedAt:   timestamppb.New(post.UpdatedAt),
+	}
+}
+
+// convertNullTime converts a sql.NullTime to a timestamp, nil if it is not set
+func convertNullTime(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// convertPostRevision converts a db.ListPostRevisionsRow object to a pb.PostRevision object
+func convertPostRevision(revision db.ListPostRevisionsRow) *pb.PostRevision {
+	res := &pb.PostRevision{
+		Revision:       revision.Revision,
+		Title:          revision.Title,
+		EditorUsername: revision.EditorUsername,
+		CreatedAt:      timestamppb.New(revision.CreatedAt),
+	}
+	if revision.RestoredFrom.Valid {
+		res.RestoredFrom = &revision.RestoredFrom.Int32
+	}
+	return res
+}
+
+// convertSession converts a db.ListActiveSessionsRow object to a pb.Session object
+func convertSession(session db.ListActiveSessionsRow) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+}
@@ -1,11 +1,22 @@
 package gapi
 
 import (
+	"context"
+	"github.com/aalug/blog-go/lockout"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"time"
 )
 
+// retryAfterHeader is sent with ResourceExhausted errors,
+// the HTTP gateway forwards it as the Retry-After header
+const retryAfterHeader = "retry-after"
+
 // fieldViolation is a helper function to create a FieldViolation.
 func fieldViolation(field string, err error) *errdetails.BadRequest_FieldViolation {
 	return &errdetails.BadRequest_FieldViolation{
@@ -30,3 +41,20 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+// resourceExhaustedError is a helper function to create a ResourceExhausted error
+// with the time after which the request can be retried.
+func resourceExhaustedError(ctx context.Context, message string, retryAfter time.Duration) error {
+	seconds := lockout.RetryAfterSeconds(retryAfter)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(seconds)))
+
+	retryInfo := &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(seconds) * time.Second)}
+	statusExhausted := status.New(codes.ResourceExhausted, message)
+
+	statusDetails, err := statusExhausted.WithDetails(retryInfo)
+	if err != nil {
+		return statusExhausted.Err()
+	}
+
+	return statusDetails.Err()
+}
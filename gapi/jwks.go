@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"encoding/json"
+	"github.com/aalug/blog-go/token"
+	"net/http"
+)
+
+// JWKSHandler serves the public keys used to verify the tokens as a JSON Web Key Set.
+// Tokens signed with a symmetric key cannot be verified by others, so there is no key set for them.
+func (server *Server) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		publisher, ok := server.tokenMaker.(token.KeySetPublisher)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(publisher.JWKS())
+	})
+}
@@ -0,0 +1,147 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/utils"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader is the header (and the metadata key) with the ID of a request.
+// It is passed from the HTTP gateway to the gRPC service and to the worker tasks,
+// so a request can be traced in the logs.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDMetadataKey is the request ID header as a (lowercase) metadata key
+const requestIDMetadataKey = "x-request-id"
+
+// GrpcLogger is a gRPC unary interceptor that logs the requests.
+// It makes sure the incoming metadata has a request ID and returns the ID in the response header.
+func (server *Server) GrpcLogger(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	startTime := time.Now()
+
+	ctx, requestID := withRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, requestID))
+
+	result, err := handler(ctx, req)
+	duration := time.Since(startTime)
+
+	statusCode := codes.Unknown
+	if st, ok := status.FromError(err); ok {
+		statusCode = st.Code()
+	}
+
+	logger := log.Info()
+	if err != nil {
+		logger = log.Error().Err(err)
+	}
+
+	logger.Str("protocol", "grpc").
+		Str("method", info.FullMethod).
+		Int("status_code", int(statusCode)).
+		Str("status_text", statusCode.String()).
+		Dur("duration", duration).
+		Str("user", server.authenticatedEmail(ctx)).
+		Str("request_id", requestID).
+		Msg("received a gRPC request")
+
+	return result, err
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	StatusCode int
+	Body       []byte
+}
+
+func (rec *responseRecorder) WriteHeader(statusCode int) {
+	rec.StatusCode = statusCode
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *responseRecorder) Write(body []byte) (int, error) {
+	rec.Body = body
+	return rec.ResponseWriter.Write(body)
+}
+
+// HTTPLogger is an HTTP middleware of the gateway that logs the requests.
+// It sets the request ID header of the request, which the gateway passes to the gRPC service,
+// and of the response.
+func (server *Server) HTTPLogger(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		startTime := time.Now()
+
+		requestID := req.Header.Get(RequestIDHeader)
+		if !utils.IsValidRequestID(requestID) {
+			requestID = uuid.NewString()
+			req.Header.Set(RequestIDHeader, requestID)
+		}
+		res.Header().Set(RequestIDHeader, requestID)
+
+		rec := &responseRecorder{
+			ResponseWriter: res,
+			StatusCode:     http.StatusOK,
+		}
+		handler.ServeHTTP(rec, req)
+		duration := time.Since(startTime)
+
+		logger := log.Info()
+		if rec.StatusCode >= http.StatusInternalServerError {
+			logger = log.Error().Bytes("body", rec.Body)
+		}
+
+		var user string
+		if accessToken, err := parseAuthorizationHeader(req.Header.Get(authorizationHeader)); err == nil {
+			if payload, err := server.tokenMaker.VerifyToken(accessToken); err == nil {
+				user = payload.Email
+			}
+		}
+
+		logger.Str("protocol", "http").
+			Str("method", req.Method).
+			Str("path", req.RequestURI).
+			Int("status_code", rec.StatusCode).
+			Str("status_text", http.StatusText(rec.StatusCode)).
+			Dur("duration", duration).
+			Str("user", user).
+			Str("request_id", requestID).
+			Msg("received an HTTP request")
+	})
+}
+
+// requestID returns the request ID from the incoming metadata
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 && utils.IsValidRequestID(values[0]) {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+// withRequestID returns the request ID from the incoming metadata or,
+// if there is none, a new ID and the context with the ID in the incoming metadata
+func withRequestID(ctx context.Context) (context.Context, string) {
+	if id := requestID(ctx); id != "" {
+		return ctx, id
+	}
+
+	id := uuid.NewString()
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(requestIDMetadataKey, id)
+
+	return metadata.NewIncomingContext(ctx, md), id
+}
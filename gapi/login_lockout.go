@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/aalug/blog-go/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// checkLoginLockout returns a ResourceExhausted error
+// if the account or the client IP is locked after too many failed login attempts
+func (server *Server) checkLoginLockout(ctx context.Context, email, clientIP string) error {
+	retryAfter, err := server.loginLimiter.Check(ctx, email, clientIP)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check login attempts: %s", err)
+	}
+
+	if retryAfter > 0 {
+		message := fmt.Sprintf("too many failed login attempts, try again in %s", retryAfter.Round(time.Second))
+		return resourceExhaustedError(ctx, message, retryAfter)
+	}
+
+	return nil
+}
+
+// failLogin records a failed login attempt and, if it has locked the account,
+// sends an email about it to the owner of the account
+func (server *Server) failLogin(ctx context.Context, email, clientIP string) error {
+	failure, err := server.loginLimiter.Fail(ctx, email, clientIP)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %s", err)
+	}
+
+	if !failure.AccountLocked {
+		return nil
+	}
+
+	taskPayload := &worker.PayloadSendLoginLockoutEmail{
+		Email:       email,
+		ClientIP:    clientIP,
+		LockedUntil: failure.LockedUntil,
+		RequestID:   requestID(ctx),
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	err = server.taskDistributor.DistributeTaskSendLoginLockoutEmail(ctx, taskPayload, opts...)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to distribute task: %s", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package gapi
 
 import (
 	"context"
+	"github.com/aalug/blog-go/utils"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -20,6 +21,7 @@ type Metadata struct {
 // extractMetadata extracts metadata from context
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 	data := &Metadata{}
+	var forwardedFor []string
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		// for HTTP
@@ -32,15 +34,13 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 			data.UserAgent = userAgent[0]
 		}
 
-		// for HTTP
-		if clientIP := md.Get(xForwardedForHeader); len(clientIP) > 0 {
-			data.ClientIP = clientIP[0]
-		}
+		forwardedFor = md.Get(xForwardedForHeader)
 	}
 
-	// for gRPC
+	// for gRPC the peer is the client, for HTTP it is the gateway,
+	// which appends the address of the client to x-forwarded-for
 	if p, ok := peer.FromContext(ctx); ok {
-		data.ClientIP = p.Addr.String()
+		data.ClientIP = utils.ClientIP(p.Addr.String(), forwardedFor, server.trustedProxies)
 	}
 
 	return data
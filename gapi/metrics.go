@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// MetricsInterceptor is a gRPC unary interceptor that records the number
+// and the latency of requests per full method name, for example "/pb.BlogGo/LoginUser"
+func (server *Server) MetricsInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	startTime := time.Now()
+	result, err := handler(ctx, req)
+	duration := time.Since(startTime)
+
+	metrics.GRPCRequestsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod).Observe(duration.Seconds())
+
+	return result, err
+}
@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// healthServicePrefix is the prefix of the full method names of the gRPC health service
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// RateLimitInterceptor is a gRPC unary interceptor that limits requests per authenticated user
+// or, for anonymous requests, per client IP. RPCs are named by their full method names,
+// for example "/pb.BlogGo/LoginUser". The health checks are not limited.
+func (server *Server) RateLimitInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	subject := ratelimit.IPSubject(server.extractMetadata(ctx).ClientIP)
+	if email := server.authenticatedEmail(ctx); email != "" {
+		subject = ratelimit.UserSubject(email)
+	}
+
+	result, err := server.rateLimiter.Allow(ctx, info.FullMethod, subject)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check rate limit: %s", err)
+	}
+
+	if !result.Allowed {
+		return nil, resourceExhaustedError(ctx, "rate limit exceeded", result.RetryAfter)
+	}
+
+	return handler(ctx, req)
+}
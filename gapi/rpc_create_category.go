@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCategory creates a new category
+func (server *Server) CreateCategory(ctx context.Context, request *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateCategoryRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	category, err := server.store.CreateCategory(ctx, request.GetName())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "category already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create category: %s", err)
+	}
+
+	res := &pb.CreateCategoryResponse{
+		Category: convertCategory(category),
+	}
+
+	return res, nil
+}
+
+// validateCreateCategoryRequest validates all the fields of the create category request.
+func validateCreateCategoryRequest(request *pb.CreateCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateCategoryName(request.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	return violations
+}
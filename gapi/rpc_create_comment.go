@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// CreateComment creates a comment for a post. The authenticated user
+// is set as the comment author. If parent_id is set, the comment is a reply.
+// Posts that are not published can be commented only by their authors.
+// Comments that break the moderation rules are not published until a moderator approves them.
+func (server *Server) CreateComment(ctx context.Context, request *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateCommentRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	// posts that are not published can be commented only by their authors
+	post, err := server.store.GetMinimalPostData(ctx, int64(request.GetPostId()))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+	if err == sql.ErrNoRows || (post.Status != db.PostStatusPublished && post.AuthorID != int32(authUser.ID)) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	params := db.CreateCommentParams{
+		Content: request.GetContent(),
+		UserID:  int32(authUser.ID),
+		PostID:  request.GetPostId(),
+	}
+
+	if request.ParentId != nil {
+		parent, err := server.store.GetComment(ctx, request.GetParentId())
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get parent comment: %s", err)
+		}
+
+		// comments waiting for the moderation cannot be replied to
+		if err == sql.ErrNoRows || parent.Status != db.CommentStatusApproved {
+			return nil, status.Errorf(codes.NotFound, "parent comment not found")
+		}
+
+		if parent.PostID != request.GetPostId() {
+			return nil, status.Errorf(codes.InvalidArgument, "parent comment belongs to a different post")
+		}
+
+		if parent.IsDeleted {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot reply to a deleted comment")
+		}
+
+		params.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	}
+
+	rules := server.config.CommentRules()
+	var stats utils.CommenterStats
+	if rules.NeedsHistory() {
+		row, err := server.store.GetCommenterStats(ctx, db.GetCommenterStatsParams{
+			UserID: int32(authUser.ID),
+			Since:  time.Now().Add(-rules.RateWindow),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get comment history: %s", err)
+		}
+		stats = utils.CommenterStats{
+			ApprovedComments: row.ApprovedComments,
+			RecentComments:   row.RecentComments,
+		}
+	}
+	commentStatus, reason := rules.Check(request.GetContent(), stats)
+	params.Status = db.CommentStatus(commentStatus)
+	params.ModerationReason = reason
+
+	comment, err := server.store.CreateComment(ctx, params)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "foreign_key_violation":
+				return nil, status.Errorf(codes.NotFound, "post not found: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %s", err)
+	}
+
+	res := &pb.CreateCommentResponse{
+		Comment: convertComment(comment, authUser.Username),
+	}
+
+	return res, nil
+}
+
+// validateCreateCommentRequest validates all the fields of the create comment request.
+func validateCreateCommentRequest(request *pb.CreateCommentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateCommentContent(request.GetContent()); err != nil {
+		violations = append(violations, fieldViolation("content", err))
+	}
+
+	if err := validation.ValidateID(int64(request.GetPostId())); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if request.ParentId != nil {
+		if err := validation.ValidateID(request.GetParentId()); err != nil {
+			violations = append(violations, fieldViolation("parent_id", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// CreatePost creates a new post. The authenticated user is set as the author.
+func (server *Server) CreatePost(ctx context.Context, request *pb.CreatePostRequest) (*pb.CreatePostResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.AuthorRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreatePostRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	postStatus := db.PostStatusPublished
+	if request.Status != nil {
+		postStatus = db.PostStatus(request.GetStatus())
+	}
+	publishAt := sql.NullTime{}
+	if request.PublishAt != nil {
+		publishAt = sql.NullTime{Time: request.GetPublishAt().AsTime(), Valid: true}
+	}
+
+	// get or create category and get the id
+	categoryID, err := server.store.GetOrCreateCategory(ctx, request.GetCategory())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get or create category: %s", err)
+	}
+
+	post, err := server.store.CreatePost(ctx, db.CreatePostParams{
+		Title:       request.GetTitle(),
+		Description: request.GetDescription(),
+		Content:     request.GetContent(),
+		AuthorID:    int32(authUser.ID),
+		CategoryID:  int32(categoryID),
+		Image:       request.GetImage(),
+		Status:      postStatus,
+		PublishAt:   publishAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create post: %s", err)
+	}
+
+	err = server.store.AddTagsToPost(ctx, db.AddTagsToPostParams{
+		PostID: post.ID,
+		Tags:   request.GetTags(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add tags to post: %s", err)
+	}
+
+	res := &pb.CreatePostResponse{
+		Post: &pb.Post{
+			Id:          post.ID,
+			Title:       post.Title,
+			Description: post.Description,
+			Content:     post.Content,
+			Author:      authUser.Username,
+			Category:    request.GetCategory(),
+			Tags:        request.GetTags(),
+			Image:       post.Image,
+			CreatedAt:   timestamppb.New(post.CreatedAt),
+			Status:      string(post.Status),
+			PublishAt:   convertNullTime(post.PublishAt),
+		},
+	}
+
+	return res, nil
+}
+
+// validateCreatePostRequest validates all the fields of the create post request.
+func validateCreatePostRequest(request *pb.CreatePostRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePostTitle(request.GetTitle()); err != nil {
+		violations = append(violations, fieldViolation("title", err))
+	}
+
+	if err := validation.ValidatePostDescription(request.GetDescription()); err != nil {
+		violations = append(violations, fieldViolation("description", err))
+	}
+
+	if err := validation.ValidatePostContent(request.GetContent()); err != nil {
+		violations = append(violations, fieldViolation("content", err))
+	}
+
+	if err := validation.ValidateTags(request.GetTags()); err != nil {
+		violations = append(violations, fieldViolation("tags", err))
+	}
+
+	if err := validation.ValidateCategoryName(request.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	if err := validation.ValidateImage(request.GetImage()); err != nil {
+		violations = append(violations, fieldViolation("image", err))
+	}
+
+	postStatus := "published"
+	if request.Status != nil {
+		postStatus = request.GetStatus()
+		if err := validation.ValidatePostStatus(postStatus); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	var publishAt time.Time
+	if request.PublishAt != nil {
+		publishAt = request.GetPublishAt().AsTime()
+	}
+	if err := validation.ValidatePostSchedule(postStatus, publishAt); err != nil {
+		violations = append(violations, fieldViolation("publish_at", err))
+	}
+
+	return violations
+}
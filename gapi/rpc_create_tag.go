@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTag creates a new tag
+func (server *Server) CreateTag(ctx context.Context, request *pb.CreateTagRequest) (*pb.CreateTagResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateTagRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tag, err := server.store.CreateTag(ctx, request.GetName())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "tag already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create tag: %s", err)
+	}
+
+	res := &pb.CreateTagResponse{
+		Tag: convertTag(tag),
+	}
+
+	return res, nil
+}
+
+// validateCreateTagRequest validates all the fields of the create tag request.
+func validateCreateTagRequest(request *pb.CreateTagRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateTagName(request.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	return violations
+}
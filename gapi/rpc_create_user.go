@@ -7,12 +7,10 @@ import (
 	"github.com/aalug/blog-go/utils"
 	"github.com/aalug/blog-go/validation"
 	"github.com/aalug/blog-go/worker"
-	"github.com/hibiken/asynq"
 	"github.com/lib/pq"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
 )
 
 // CreateUser creates a new user
@@ -33,18 +31,24 @@ func (server *Server) CreateUser(ctx context.Context, request *pb.CreateUserRequ
 			HashedPassword: hashedPassword,
 			Email:          request.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
+		AfterCreate: func(user db.User) ([]db.CreateOutboxMessageParams, error) {
 			taskPayload := &worker.PayloadSendVerificationEmail{
-				Email: user.Email,
+				Email:     user.Email,
+				RequestID: requestID(ctx),
 			}
 
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
+			opts := worker.OutboxOptions{
+				MaxRetry: 10,
+				Queue:    worker.QueueCritical,
 			}
 
-			return server.taskDistributor.DistributeTaskSendVerificationEmail(ctx, taskPayload, opts...)
+			// the task is enqueued by the outbox relay only if the user is created
+			message, err := worker.OutboxTaskSendVerificationEmail(ctx, taskPayload, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			return []db.CreateOutboxMessageParams{message}, nil
 		},
 	}
 
@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteCategory deletes a category. Categories that still have posts cannot be deleted.
+func (server *Server) DeleteCategory(ctx context.Context, request *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteCategoryRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.store.DeleteCategory(ctx, request.GetName())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "foreign_key_violation":
+				return nil, status.Errorf(codes.FailedPrecondition, "category still has posts: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete category: %s", err)
+	}
+
+	return &pb.DeleteCategoryResponse{}, nil
+}
+
+// validateDeleteCategoryRequest validates all the fields of the delete category request.
+func validateDeleteCategoryRequest(request *pb.DeleteCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateCategoryName(request.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	return violations
+}
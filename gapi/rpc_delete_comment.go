@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteComment deletes a comment. Only the author of the comment or a moderator is allowed to delete it.
+func (server *Server) DeleteComment(ctx context.Context, request *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteCommentRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// get the comment to check if it exists and
+	// if the authenticated user is the author
+	comment, err := server.store.GetComment(ctx, request.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "comment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get comment: %s", err)
+	}
+
+	if comment.IsDeleted {
+		return nil, status.Errorf(codes.NotFound, "comment has been deleted")
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	if comment.UserID != int32(authUser.ID) && !utils.HasRole(string(authUser.Role), utils.ModeratorRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "comment does not belong to the authenticated user")
+	}
+
+	// comments with replies are replaced with a placeholder
+	_, err = server.store.DeleteCommentTx(ctx, request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete comment: %s", err)
+	}
+
+	return &pb.DeleteCommentResponse{}, nil
+}
+
+// validateDeleteCommentRequest validates all the fields of the delete comment request.
+func validateDeleteCommentRequest(request *pb.DeleteCommentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeletePost deletes a post. Only the author of the post or a moderator is allowed to delete it.
+func (server *Server) DeletePost(ctx context.Context, request *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeletePostRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	// get the bare minimum post data to check if the
+	// authenticated user is the author of this post
+	post, err := server.store.GetMinimalPostData(ctx, request.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+
+	if post.AuthorID != int32(authUser.ID) && !utils.HasRole(string(authUser.Role), utils.ModeratorRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "post does not belong to the authenticated user")
+	}
+
+	err = server.store.DeletePost(ctx, request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete post: %s", err)
+	}
+
+	return &pb.DeletePostResponse{}, nil
+}
+
+// validateDeletePostRequest validates all the fields of the delete post request.
+func validateDeletePostRequest(request *pb.DeletePostRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
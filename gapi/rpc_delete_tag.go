@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteTag deletes a tag. Tags that are still used by posts cannot be deleted.
+func (server *Server) DeleteTag(ctx context.Context, request *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeleteTagRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.store.DeleteTag(ctx, request.GetName())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "foreign_key_violation":
+				return nil, status.Errorf(codes.FailedPrecondition, "tag is still used by posts: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete tag: %s", err)
+	}
+
+	return &pb.DeleteTagResponse{}, nil
+}
+
+// validateDeleteTagRequest validates all the fields of the delete tag request.
+func validateDeleteTagRequest(request *pb.DeleteTagRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateTagName(request.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiffPostRevisions returns the unified diff between two revisions of the post
+func (server *Server) DiffPostRevisions(ctx context.Context, request *pb.DiffPostRevisionsRequest) (*pb.DiffPostRevisionsResponse, error) {
+	violations := validateDiffPostRevisionsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizePostAuthor(ctx, request.GetPostId()); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]db.PostRevision, 2)
+	for i, number := range []int32{request.GetFrom(), request.GetTo()} {
+		revision, err := server.store.GetPostRevision(ctx, db.GetPostRevisionParams{
+			PostID:   request.GetPostId(),
+			Revision: number,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "revision %d not found", number)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get post revision: %s", err)
+		}
+		revisions[i] = revision
+	}
+
+	diff, err := utils.UnifiedDiff(
+		fmt.Sprintf("revision %d", request.GetFrom()),
+		utils.PostDiffText(revisions[0].Title, revisions[0].Description, revisions[0].Content),
+		fmt.Sprintf("revision %d", request.GetTo()),
+		utils.PostDiffText(revisions[1].Title, revisions[1].Description, revisions[1].Content),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to diff post revisions: %s", err)
+	}
+
+	res := &pb.DiffPostRevisionsResponse{
+		From: request.GetFrom(),
+		To:   request.GetTo(),
+		Diff: diff,
+	}
+
+	return res, nil
+}
+
+// validateDiffPostRevisionsRequest validates all the fields of the diff post revisions request.
+func validateDiffPostRevisionsRequest(request *pb.DiffPostRevisionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetPostId()); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if err := validation.ValidateRevision(request.GetFrom()); err != nil {
+		violations = append(violations, fieldViolation("from", err))
+	}
+
+	if err := validation.ValidateRevision(request.GetTo()); err != nil {
+		violations = append(violations, fieldViolation("to", err))
+	}
+
+	return violations
+}
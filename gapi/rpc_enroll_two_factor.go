@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollTwoFactor generates a new TOTP secret and recovery codes of the authenticated user.
+// The two-factor authentication is enabled only after a code is confirmed with VerifyTwoFactor.
+// Enrolling again before that replaces the secret and the recovery codes.
+func (server *Server) EnrollTwoFactor(ctx context.Context, _ *pb.EnrollTwoFactorRequest) (*pb.EnrollTwoFactorResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, provisioningURI, err := utils.GenerateTOTPKey(server.config.TwoFactorIssuer, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate TOTP key: %s", err)
+	}
+
+	recoveryCodes, err := utils.GenerateRecoveryCodes(utils.RecoveryCodesCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %s", err)
+	}
+
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedRecoveryCodes[i] = utils.HashRecoveryCode(code)
+	}
+
+	_, err = server.store.EnrollTwoFactorTx(ctx, db.EnrollTwoFactorTxParams{
+		Email:               authPayload.Email,
+		Secret:              secret,
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to enroll two-factor authentication: %s", err)
+	}
+
+	res := &pb.EnrollTwoFactorResponse{
+		Secret:          secret,
+		ProvisioningUri: provisioningURI,
+		RecoveryCodes:   recoveryCodes,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"github.com/aalug/blog-go/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ForgotPassword sends an email with a password reset code to the user.
+// The response is the same whether the user exists or not.
+func (server *Server) ForgotPassword(ctx context.Context, request *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
+	violations := validateForgotPasswordRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.GetUser(ctx, request.GetEmail())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &pb.ForgotPasswordResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	taskPayload := &worker.PayloadSendResetPasswordEmail{
+		Email:     user.Email,
+		RequestID: requestID(ctx),
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	err = server.taskDistributor.DistributeTaskSendResetPasswordEmail(ctx, taskPayload, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to distribute task: %s", err)
+	}
+
+	return &pb.ForgotPasswordResponse{}, nil
+}
+
+// validateForgotPasswordRequest validates all the fields of the forgot password request.
+func validateForgotPasswordRequest(request *pb.ForgotPasswordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateEmail(request.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPost gets post details by id. Posts that are not published
+// are visible only to their authors.
+func (server *Server) GetPost(ctx context.Context, request *pb.GetPostRequest) (*pb.GetPostResponse, error) {
+	violations := validateGetPostRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	post, err := server.store.GetPostByID(ctx, request.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+
+	if !server.isPostVisible(ctx, post.Status, post.AuthorEmail) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	tags, err := server.store.GetTagsOfPost(ctx, post.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tags of post: %s", err)
+	}
+
+	res := &pb.GetPostResponse{
+		Post: convertPost(post, tags),
+	}
+
+	return res, nil
+}
+
+// validateGetPostRequest validates all the fields of the get post request.
+func validateGetPostRequest(request *pb.GetPostRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
+
+// isPostVisible reports whether the post with the given status can be shown
+// to the caller. Only published posts are public, the rest are visible
+// to their authors if the request carries a valid access token.
+func (server *Server) isPostVisible(ctx context.Context, postStatus db.PostStatus, authorEmail string) bool {
+	if postStatus == db.PostStatusPublished {
+		return true
+	}
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	return err == nil && authPayload.Email == authorEmail
+}
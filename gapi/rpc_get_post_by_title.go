@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// GetPostByTitle gets post details by title. The title is passed as a slug
+// with hyphens instead of spaces.
+func (server *Server) GetPostByTitle(ctx context.Context, request *pb.GetPostByTitleRequest) (*pb.GetPostByTitleResponse, error) {
+	violations := validateGetPostByTitleRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	title := strings.ReplaceAll(request.GetSlug(), "-", " ")
+
+	post, err := server.store.GetPostByTitle(ctx, title)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+
+	if !server.isPostVisible(ctx, post.Status, post.AuthorEmail) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+
+	tags, err := server.store.GetTagsOfPost(ctx, post.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tags of post: %s", err)
+	}
+
+	res := &pb.GetPostByTitleResponse{
+		Post: convertPost(db.GetPostByIDRow(post), tags),
+	}
+
+	return res, nil
+}
+
+// validateGetPostByTitleRequest validates all the fields of the get post by title request.
+func validateGetPostByTitleRequest(request *pb.GetPostByTitleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateSlug(request.GetSlug()); err != nil {
+		violations = append(violations, fieldViolation("slug", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCategories lists categories ordered by name
+func (server *Server) ListCategories(ctx context.Context, request *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	violations := validateListCategoriesRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	categories, err := server.store.ListCategories(ctx, db.ListCategoriesParams{
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %s", err)
+	}
+
+	res := &pb.ListCategoriesResponse{
+		Categories: make([]*pb.Category, len(categories)),
+	}
+	for i, category := range categories {
+		res.Categories[i] = convertCategory(category)
+	}
+
+	return res, nil
+}
+
+// validateListCategoriesRequest validates all the fields of the list categories request.
+func validateListCategoriesRequest(request *pb.ListCategoriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCommentReplies lists direct replies of a comment, the oldest first.
+// Every reply has its own reply count, so the clients can load deeper levels on demand.
+func (server *Server) ListCommentReplies(ctx context.Context, request *pb.ListCommentRepliesRequest) (*pb.ListCommentRepliesResponse, error) {
+	violations := validateListCommentRepliesRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	replies, err := server.store.ListCommentReplies(ctx, db.ListCommentRepliesParams{
+		ParentID: sql.NullInt64{Int64: request.GetCommentId(), Valid: true},
+		Limit:    request.GetPageSize(),
+		Offset:   (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list replies: %s", err)
+	}
+
+	res := &pb.ListCommentRepliesResponse{
+		Replies: make([]*pb.Comment, len(replies)),
+	}
+	for i, reply := range replies {
+		res.Replies[i] = convertCommentReply(reply)
+	}
+
+	return res, nil
+}
+
+// validateListCommentRepliesRequest validates all the fields of the list comment replies request.
+func validateListCommentRepliesRequest(request *pb.ListCommentRepliesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetCommentId()); err != nil {
+		violations = append(violations, fieldViolation("comment_id", err))
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCommentTree lists top-level comments of a post, the newest first,
+// with all their replies nested under them. Pagination applies only to the top-level comments.
+func (server *Server) ListCommentTree(ctx context.Context, request *pb.ListCommentTreeRequest) (*pb.ListCommentTreeResponse, error) {
+	violations := validateListCommentTreeRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.ListCommentTree(ctx, db.ListCommentTreeParams{
+		PostID: request.GetPostId(),
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list comments: %s", err)
+	}
+
+	res := &pb.ListCommentTreeResponse{
+		Comments: convertCommentTree(rows, request.GetPostId()),
+	}
+
+	return res, nil
+}
+
+// validateListCommentTreeRequest validates all the fields of the list comment tree request.
+func validateListCommentTreeRequest(request *pb.ListCommentTreeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(request.GetPostId())); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListComments lists comments for a post, the newest first
+func (server *Server) ListComments(ctx context.Context, request *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	violations := validateListCommentsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	comments, err := server.store.ListCommentsForPost(ctx, db.ListCommentsForPostParams{
+		PostID: request.GetPostId(),
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list comments: %s", err)
+	}
+
+	res := &pb.ListCommentsResponse{
+		Comments: make([]*pb.Comment, len(comments)),
+	}
+	for i, comment := range comments {
+		res.Comments[i] = convertListCommentsRow(comment, request.GetPostId())
+	}
+
+	return res, nil
+}
+
+// validateListCommentsRequest validates all the fields of the list comments request.
+func validateListCommentsRequest(request *pb.ListCommentsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(int64(request.GetPostId())); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListModerationQueue lists comments with the given moderation status (pending by default),
+// the oldest first. Only moderators are allowed to do it.
+func (server *Server) ListModerationQueue(ctx context.Context, request *pb.ListModerationQueueRequest) (*pb.ListModerationQueueResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.ModeratorRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListModerationQueueRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	commentStatus := utils.CommentStatusPending
+	if request.Status != nil {
+		commentStatus = request.GetStatus()
+	}
+
+	comments, err := server.store.ListModerationQueue(ctx, db.ListModerationQueueParams{
+		Status: db.CommentStatus(commentStatus),
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list moderation queue: %s", err)
+	}
+
+	res := &pb.ListModerationQueueResponse{
+		Comments: make([]*pb.Comment, len(comments)),
+	}
+	for i, comment := range comments {
+		res.Comments[i] = convertModerationQueueRow(comment)
+	}
+
+	return res, nil
+}
+
+// validateListModerationQueueRequest validates all the fields of the list moderation queue request.
+func validateListModerationQueueRequest(request *pb.ListModerationQueueRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if request.Status != nil {
+		if err := validation.ValidateModerationQueueStatus(request.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
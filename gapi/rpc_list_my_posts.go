@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListMyPosts lists posts of the authenticated user in every status,
+// optionally filtered by the given status
+func (server *Server) ListMyPosts(ctx context.Context, request *pb.ListMyPostsRequest) (*pb.ListMyPostsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListMyPostsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	posts, err := server.store.ListOwnPosts(ctx, db.ListOwnPostsParams{
+		AuthorID: int32(authUser.ID),
+		Status: db.NullPostStatus{
+			PostStatus: db.PostStatus(request.GetStatus()),
+			Valid:      request.Status != nil,
+		},
+		PageLimit:  request.GetPageSize(),
+		PageOffset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %s", err)
+	}
+
+	res := &pb.ListMyPostsResponse{
+		Posts: make([]*pb.OwnPostListItem, len(posts)),
+	}
+	for i, post := range posts {
+		res.Posts[i] = convertOwnPostListItem(post)
+	}
+
+	return res, nil
+}
+
+// validateListMyPostsRequest validates all the fields of the list my posts request.
+func validateListMyPostsRequest(request *pb.ListMyPostsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if request.Status != nil {
+		if err := validation.ValidatePostStatus(request.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
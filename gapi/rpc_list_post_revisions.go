@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPostRevisions lists revisions of the post, the newest first.
+// Only the author of the post can see its history.
+func (server *Server) ListPostRevisions(ctx context.Context, request *pb.ListPostRevisionsRequest) (*pb.ListPostRevisionsResponse, error) {
+	violations := validateListPostRevisionsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizePostAuthor(ctx, request.GetPostId()); err != nil {
+		return nil, err
+	}
+
+	revisions, err := server.store.ListPostRevisions(ctx, db.ListPostRevisionsParams{
+		PostID: request.GetPostId(),
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list post revisions: %s", err)
+	}
+
+	res := &pb.ListPostRevisionsResponse{
+		Revisions: make([]*pb.PostRevision, len(revisions)),
+	}
+	for i, revision := range revisions {
+		res.Revisions[i] = convertPostRevision(revision)
+	}
+
+	return res, nil
+}
+
+// validateListPostRevisionsRequest validates all the fields of the list post revisions request.
+func validateListPostRevisionsRequest(request *pb.ListPostRevisionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetPostId()); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
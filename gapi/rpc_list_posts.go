@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPosts lists all posts, the newest first
+func (server *Server) ListPosts(ctx context.Context, request *pb.ListPostsRequest) (*pb.ListPostsResponse, error) {
+	violations := validateListPostsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	posts, err := server.store.ListPosts(ctx, db.ListPostsParams{
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %s", err)
+	}
+
+	res := &pb.ListPostsResponse{
+		Posts: make([]*pb.PostListItem, len(posts)),
+	}
+	for i, post := range posts {
+		res.Posts[i] = convertPostListItem(post)
+	}
+
+	return res, nil
+}
+
+// validateListPostsRequest validates all the fields of the list posts request.
+func validateListPostsRequest(request *pb.ListPostsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPostsByAuthor lists all posts created by users with username or email
+// containing the string in the request
+func (server *Server) ListPostsByAuthor(ctx context.Context, request *pb.ListPostsByAuthorRequest) (*pb.ListPostsByAuthorResponse, error) {
+	violations := validateListPostsByAuthorRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// get all authors containing the string in the request
+	authors, err := server.store.ListUsersContainingString(ctx, request.GetAuthor())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list authors: %s", err)
+	}
+
+	if len(authors) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no users found")
+	}
+
+	res := &pb.ListPostsByAuthorResponse{
+		Posts: []*pb.PostListItem{},
+	}
+	for _, author := range authors {
+		posts, err := server.store.ListPostsByAuthor(ctx, db.ListPostsByAuthorParams{
+			AuthorID: int32(author.ID),
+			Limit:    request.GetPageSize(),
+			Offset:   (request.GetPage() - 1) * request.GetPageSize(),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list posts: %s", err)
+		}
+
+		for _, post := range posts {
+			res.Posts = append(res.Posts, convertPostListItem(db.ListPostsRow(post)))
+		}
+	}
+
+	return res, nil
+}
+
+// validateListPostsByAuthorRequest validates all the fields of the list posts by author request.
+func validateListPostsByAuthorRequest(request *pb.ListPostsByAuthorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := validation.ValidateStringLength(request.GetAuthor(), 1, 250); err != nil {
+		violations = append(violations, fieldViolation("author", err))
+	}
+
+	return violations
+}
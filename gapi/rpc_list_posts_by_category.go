@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPostsByCategory lists posts from the given category
+func (server *Server) ListPostsByCategory(ctx context.Context, request *pb.ListPostsByCategoryRequest) (*pb.ListPostsByCategoryResponse, error) {
+	violations := validateListPostsByCategoryRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	posts, err := server.store.ListPostsByCategory(ctx, db.ListPostsByCategoryParams{
+		ID:     request.GetCategoryId(),
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %s", err)
+	}
+
+	res := &pb.ListPostsByCategoryResponse{
+		Posts: make([]*pb.PostListItem, len(posts)),
+	}
+	for i, post := range posts {
+		res.Posts[i] = convertPostListItem(db.ListPostsRow(post))
+	}
+
+	return res, nil
+}
+
+// validateListPostsByCategoryRequest validates all the fields of the list posts by category request.
+func validateListPostsByCategoryRequest(request *pb.ListPostsByCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := validation.ValidateID(request.GetCategoryId()); err != nil {
+		violations = append(violations, fieldViolation("category_id", err))
+	}
+
+	return violations
+}
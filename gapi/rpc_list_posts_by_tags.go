@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPostsByTags lists posts that have any of the given tags
+func (server *Server) ListPostsByTags(ctx context.Context, request *pb.ListPostsByTagsRequest) (*pb.ListPostsByTagsResponse, error) {
+	violations := validateListPostsByTagsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	posts, err := server.store.ListPostsByTags(ctx, db.ListPostsByTagsParams{
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+		TagIds: request.GetTagIds(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %s", err)
+	}
+
+	res := &pb.ListPostsByTagsResponse{
+		Posts: make([]*pb.PostListItem, len(posts)),
+	}
+	for i, post := range posts {
+		res.Posts[i] = convertPostListItem(db.ListPostsRow(post))
+	}
+
+	return res, nil
+}
+
+// validateListPostsByTagsRequest validates all the fields of the list posts by tags request.
+func validateListPostsByTagsRequest(request *pb.ListPostsByTagsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if len(request.GetTagIds()) == 0 {
+		violations = append(violations, fieldViolation("tag_ids", fmt.Errorf("at least one tag id is required")))
+	}
+	for _, tagID := range request.GetTagIds() {
+		if err := validation.ValidateID(int64(tagID)); err != nil {
+			violations = append(violations, fieldViolation("tag_ids", err))
+			break
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSessions lists active (not blocked and not expired) sessions of the authenticated user, the newest first.
+func (server *Server) ListSessions(ctx context.Context, _ *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := server.store.ListActiveSessions(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %s", err)
+	}
+
+	res := &pb.ListSessionsResponse{
+		Sessions: make([]*pb.Session, len(sessions)),
+	}
+	for i, session := range sessions {
+		res.Sessions[i] = convertSession(session)
+	}
+
+	return res, nil
+}
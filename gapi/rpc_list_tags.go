@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTags lists tags ordered by name
+func (server *Server) ListTags(ctx context.Context, request *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	violations := validateListTagsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tags, err := server.store.ListTags(ctx, db.ListTagsParams{
+		Limit:  request.GetPageSize(),
+		Offset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %s", err)
+	}
+
+	res := &pb.ListTagsResponse{
+		Tags: make([]*pb.Tag, len(tags)),
+	}
+	for i, tag := range tags {
+		res.Tags[i] = convertTag(tag)
+	}
+
+	return res, nil
+}
+
+// validateListTagsRequest validates all the fields of the list tags request.
+func validateListTagsRequest(request *pb.ListTagsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
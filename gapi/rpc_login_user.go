@@ -7,22 +7,34 @@ import (
 	"github.com/aalug/blog-go/pb"
 	"github.com/aalug/blog-go/utils"
 	"github.com/aalug/blog-go/validation"
+	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// LoginUser handles user login
+// LoginUser handles user login. If the user has the two-factor authentication enabled,
+// it returns only a two-factor token to be used with a TOTP or recovery code in LoginUserTwoFactor.
 func (server *Server) LoginUser(ctx context.Context, request *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
 	violations := validateLoginUserRequest(request)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
+	clientIP := server.extractMetadata(ctx).ClientIP
+	err := server.checkLoginLockout(ctx, request.GetEmail(), clientIP)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := server.store.GetUser(ctx, request.GetEmail())
 	if err != nil {
 		if err == sql.ErrNoRows {
+			// guessing emails is limited by the IP
+			if err := server.failLogin(ctx, "", clientIP); err != nil {
+				return nil, err
+			}
 			return nil, status.Errorf(codes.NotFound, "user not found: %s", err)
 		}
 		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
@@ -30,16 +42,72 @@ func (server *Server) LoginUser(ctx context.Context, request *pb.LoginUserReques
 
 	err = utils.CheckPassword(request.GetPassword(), user.HashedPassword)
 	if err != nil {
+		if err := server.failLogin(ctx, user.Email, clientIP); err != nil {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.NotFound, "invalid password: %s", err)
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.AccessTokenDuration)
+	return server.completeLogin(ctx, user)
+}
+
+// completeLogin returns the tokens and the session of the authenticated user or,
+// if the user has the two-factor authentication enabled, only a two-factor token.
+// The failed login attempts of the account are reset only when the tokens are issued.
+func (server *Server) completeLogin(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
+	if user.IsDisabled {
+		return nil, status.Errorf(codes.PermissionDenied, "account is disabled")
+	}
+
+	twoFactorEnabled, err := server.isTwoFactorEnabled(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tokens are issued only after the second step (LoginUserTwoFactor)
+	if twoFactorEnabled {
+		// every challenge allows more codes to be checked, so none is issued while the account is locked
+		err := server.checkLoginLockout(ctx, user.Email, server.extractMetadata(ctx).ClientIP)
+		if err != nil {
+			return nil, err
+		}
+
+		challenge, err := server.store.CreateTwoFactorChallenge(ctx, db.CreateTwoFactorChallengeParams{
+			ID:    uuid.New(),
+			Email: user.Email,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error creating two-factor challenge: %s", err)
+		}
+
+		res := &pb.LoginUserResponse{
+			TwoFactorRequired:       true,
+			TwoFactorToken:          challenge.ID.String(),
+			TwoFactorTokenExpiresAt: timestamppb.New(challenge.ExpiredAt),
+		}
+
+		return res, nil
+	}
+
+	err = server.loginLimiter.Succeed(ctx, user.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset login attempts: %s", err)
+	}
+
+	return server.createUserSession(ctx, user)
+}
+
+// createUserSession creates the access and refresh tokens
+// and the session of the user that has just logged in
+func (server *Server) createUserSession(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, string(user.Role), server.config.AccessTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error creating access token: %s", err)
 	}
 
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
-		user.Username,
+		user.Email,
+		string(user.Role),
 		server.config.RefreshTokenDuration,
 	)
 	if err != nil {
@@ -55,6 +123,7 @@ func (server *Server) LoginUser(ctx context.Context, request *pb.LoginUserReques
 		ClientIp:     metaData.ClientIP,
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error creating session: %s", err)
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// LoginUserTwoFactor completes the login of a user with the two-factor authentication enabled.
+// It takes the two-factor token from LoginUser and either a TOTP code or an unused recovery code.
+func (server *Server) LoginUserTwoFactor(ctx context.Context, request *pb.LoginUserTwoFactorRequest) (*pb.LoginUserResponse, error) {
+	violations := validateLoginUserTwoFactorRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the attempt is counted before the code is checked, so concurrent requests
+	// cannot check more codes than MaxTwoFactorAttempts
+	challenge, err := server.store.IncrementTwoFactorChallengeAttempts(ctx, db.IncrementTwoFactorChallengeAttemptsParams{
+		ID:          uuid.MustParse(request.GetTwoFactorToken()),
+		MaxAttempts: utils.MaxTwoFactorAttempts,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "two-factor token is invalid, used or expired")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor challenge: %s", err)
+	}
+
+	clientIP := server.extractMetadata(ctx).ClientIP
+	err = server.checkLoginLockout(ctx, challenge.Email, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := server.checkTwoFactorCode(ctx, challenge.Email, request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// a password login issues a new challenge, so the invalid codes are limited
+	// per account and per client IP like the invalid passwords
+	if !valid {
+		if err := server.failLogin(ctx, challenge.Email, clientIP); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor code")
+	}
+
+	// the token can be used only once, even by concurrent requests
+	_, err = server.store.UseTwoFactorChallenge(ctx, challenge.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "two-factor token is used or expired")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to use two-factor challenge: %s", err)
+	}
+
+	err = server.loginLimiter.Succeed(ctx, challenge.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset login attempts: %s", err)
+	}
+
+	user, err := server.store.GetUser(ctx, challenge.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	// the user could have been disabled after the first step
+	if user.IsDisabled {
+		return nil, status.Errorf(codes.PermissionDenied, "account is disabled")
+	}
+
+	return server.createUserSession(ctx, user)
+}
+
+// isTwoFactorEnabled checks if the user has the two-factor authentication enabled
+func (server *Server) isTwoFactorEnabled(ctx context.Context, email string) (bool, error) {
+	twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, status.Errorf(codes.Internal, "failed to get two-factor secret: %s", err)
+	}
+
+	return twoFactorSecret.IsEnabled, nil
+}
+
+// checkTwoFactorCode checks the TOTP code of the user or uses the recovery code,
+// so the same TOTP code or recovery code cannot be used again
+func (server *Server) checkTwoFactorCode(ctx context.Context, email, code string) (bool, error) {
+	if utils.IsTOTPCode(code) {
+		twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, email)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, status.Errorf(codes.Internal, "failed to get two-factor secret: %s", err)
+		}
+
+		step, ok := utils.TOTPCodeStep(code, twoFactorSecret.Secret, time.Now())
+		if !twoFactorSecret.IsEnabled || !ok {
+			return false, nil
+		}
+
+		// the code can be used only once, even by concurrent requests
+		_, err = server.store.UseTwoFactorStep(ctx, db.UseTwoFactorStepParams{
+			Email: email,
+			Step:  step,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, status.Errorf(codes.Internal, "failed to use two-factor code: %s", err)
+		}
+
+		return true, nil
+	}
+
+	_, err := server.store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		Email:      email,
+		HashedCode: utils.HashRecoveryCode(code),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, status.Errorf(codes.Internal, "failed to use recovery code: %s", err)
+	}
+
+	return true, nil
+}
+
+// validateLoginUserTwoFactorRequest validates all the fields of the two-factor login request.
+func validateLoginUserTwoFactorRequest(request *pb.LoginUserTwoFactorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateUUID(request.GetTwoFactorToken()); err != nil {
+		violations = append(violations, fieldViolation("two_factor_token", err))
+	}
+
+	if err := validation.ValidateTwoFactorCode(request.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
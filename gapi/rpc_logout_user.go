@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogoutUser blocks the session (with its whole family) of the given refresh token.
+func (server *Server) LogoutUser(ctx context.Context, request *pb.LogoutUserRequest) (*pb.LogoutUserResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateLogoutUserRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.GetRefreshToken())
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if refreshPayload.Email != authPayload.Email {
+		return nil, status.Errorf(codes.PermissionDenied, "session does not belong to the authenticated user")
+	}
+
+	blocked, err := server.store.BlockSession(ctx, db.BlockSessionParams{
+		ID:    refreshPayload.ID,
+		Email: authPayload.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to log out: %s", err)
+	}
+
+	if blocked == 0 {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+
+	return &pb.LogoutUserResponse{}, nil
+}
+
+// validateLogoutUserRequest validates all the fields of the logout request.
+func validateLogoutUserRequest(request *pb.LogoutUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateRefreshToken(request.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
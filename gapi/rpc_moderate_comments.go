@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxModeratedComments is the number of comments that can be moderated at once
+const maxModeratedComments = 100
+
+// ModerateComments approves or rejects comments from the moderation queue in bulk.
+// Comments that are not in the queue are skipped. Only moderators are allowed to do it.
+func (server *Server) ModerateComments(ctx context.Context, request *pb.ModerateCommentsRequest) (*pb.ModerateCommentsResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.ModeratorRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateModerateCommentsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	updatedIDs, err := server.store.UpdateCommentsStatus(ctx, db.UpdateCommentsStatusParams{
+		Status: db.CommentStatus(request.GetStatus()),
+		Ids:    request.GetIds(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to moderate comments: %s", err)
+	}
+
+	return &pb.ModerateCommentsResponse{UpdatedIds: updatedIDs}, nil
+}
+
+// validateModerateCommentsRequest validates all the fields of the moderate comments request.
+func validateModerateCommentsRequest(request *pb.ModerateCommentsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateIDs(request.GetIds(), maxModeratedComments); err != nil {
+		violations = append(violations, fieldViolation("ids", err))
+	}
+
+	if err := validation.ValidateModerationDecision(request.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/sso"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OIDCLogin starts a login with the OIDC provider. The client redirects the user to the auth URL
+// and, after the user consents, sends the code and the state from the redirect to OIDCCallback.
+func (server *Server) OIDCLogin(ctx context.Context, _ *pb.OIDCLoginRequest) (*pb.OIDCLoginResponse, error) {
+	if server.oidcProvider == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", sso.ErrNotConfigured)
+	}
+
+	request, err := sso.NewAuthRequest()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create login request: %s", err)
+	}
+
+	_, err = server.store.CreateOIDCState(ctx, db.CreateOIDCStateParams{
+		State:        request.State,
+		Nonce:        request.Nonce,
+		CodeVerifier: request.CodeVerifier,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create OIDC state: %s", err)
+	}
+
+	res := &pb.OIDCLoginResponse{
+		AuthUrl: server.oidcProvider.AuthCodeURL(request),
+		State:   request.State,
+	}
+
+	return res, nil
+}
+
+// OIDCCallback completes the login with the OIDC provider. The identity is linked
+// to the user with the same (verified) email or to a new user, then the login
+// continues as in LoginUser, including the two-factor authentication.
+func (server *Server) OIDCCallback(ctx context.Context, request *pb.OIDCCallbackRequest) (*pb.LoginUserResponse, error) {
+	if server.oidcProvider == nil {
+		return nil, status.Errorf(codes.NotFound, "%s", sso.ErrNotConfigured)
+	}
+
+	violations := validateOIDCCallbackRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the state can be used only once
+	state, err := server.store.UseOIDCState(ctx, request.GetState())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid or expired state")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to use OIDC state: %s", err)
+	}
+
+	identity, err := server.oidcProvider.Exchange(ctx, request.GetCode(), sso.AuthRequest{
+		State:        state.State,
+		Nonce:        state.Nonce,
+		CodeVerifier: state.CodeVerifier,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%s", err)
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, status.Errorf(codes.PermissionDenied, "the email of the identity is not verified")
+	}
+
+	// users created from an identity can log in only with the provider,
+	// until they reset the password
+	password, err := utils.RandomToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+	}
+
+	result, err := server.store.OIDCLoginTx(ctx, db.OIDCLoginTxParams{
+		Issuer:         identity.Issuer,
+		Subject:        identity.Subject,
+		Email:          identity.Email,
+		Username:       identity.Username(),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to log in with OIDC identity: %s", err)
+	}
+
+	return server.completeLogin(ctx, result.User)
+}
+
+// validateOIDCCallbackRequest validates all the fields of the OIDC callback request.
+func validateOIDCCallbackRequest(request *pb.OIDCCallbackRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateOIDCCode(request.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if err := validation.ValidateOIDCState(request.GetState()); err != nil {
+		violations = append(violations, fieldViolation("state", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,137 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/token"
+	"github.com/aalug/blog-go/validation"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// RenewAccessToken creates a new access token for the session of the refresh token
+// and rotates the refresh token. Blocked (revoked) and expired sessions cannot be renewed.
+// Reusing an already rotated refresh token blocks the whole session family.
+func (server *Server) RenewAccessToken(ctx context.Context, request *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	violations := validateRenewAccessTokenRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.GetRefreshToken())
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if session.ReplacedBy.Valid {
+		return nil, server.blockSessionFamily(ctx, session.FamilyID)
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "blocked session")
+	}
+
+	if session.Email != refreshPayload.Email {
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect session user")
+	}
+
+	if session.RefreshToken != request.GetRefreshToken() {
+		return nil, status.Errorf(codes.Unauthenticated, "mismatched session token")
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "expired session")
+	}
+
+	// the role is read again, so role changes apply to renewed access tokens
+	user, err := server.store.GetUser(ctx, session.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	if refreshPayload.IssuedBefore(user.PasswordChangedAt) {
+		return nil, unauthenticatedError(token.ErrRevokedToken)
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		user.Email,
+		string(user.Role),
+		server.config.AccessTokenDuration,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating access token: %s", err)
+	}
+
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(
+		user.Email,
+		string(user.Role),
+		time.Until(session.ExpiresAt),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating refresh token: %s", err)
+	}
+
+	metaData := server.extractMetadata(ctx)
+	txResult, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		ID: session.ID,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			Email:        user.Email,
+			RefreshToken: refreshToken,
+			UserAgent:    metaData.UserAgent,
+			ClientIp:     metaData.ClientIP,
+			IsBlocked:    false,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+			FamilyID:     session.FamilyID,
+		},
+	})
+	if err != nil {
+		// the session has been rotated or blocked by a concurrent request
+		if err == sql.ErrNoRows {
+			return nil, server.blockSessionFamily(ctx, session.FamilyID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rotate session: %s", err)
+	}
+
+	return &pb.RenewAccessTokenResponse{
+		SessionId:             txResult.Session.ID.String(),
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(newRefreshPayload.ExpiredAt),
+	}, nil
+}
+
+// blockSessionFamily blocks all sessions of the family after
+// a reuse of a rotated refresh token and returns the error to respond with
+func (server *Server) blockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	err := server.store.BlockSessionFamily(ctx, familyID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to revoke session: %s", err)
+	}
+
+	return status.Errorf(codes.Unauthenticated, "refresh token reuse detected, the session has been revoked")
+}
+
+// validateRenewAccessTokenRequest validates all the fields of the renew access token request.
+func validateRenewAccessTokenRequest(request *pb.RenewAccessTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateRefreshToken(request.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResetPassword sets a new password using the emailed reset code.
+// The code can be used only once and all sessions of the user are revoked.
+func (server *Server) ResetPassword(ctx context.Context, request *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	violations := validateResetPasswordRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hashedPassword, err := utils.HashPassword(request.GetNewPassword())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+	}
+
+	_, err = server.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		Email:          request.GetEmail(),
+		SecretCode:     utils.HashResetPasswordCode(request.GetCode()),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "invalid or expired reset code")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reset password: %s", err)
+	}
+
+	// tokens issued before the password change are rejected from now on
+	server.passwordChanges.Invalidate(request.GetEmail())
+
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+// validateResetPasswordRequest validates all the fields of the reset password request.
+func validateResetPasswordRequest(request *pb.ResetPasswordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateEmail(request.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	if err := validation.ValidateResetPasswordCode(request.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if err := validation.ValidatePassword(request.GetNewPassword()); err != nil {
+		violations = append(violations, fieldViolation("new_password", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RestorePostRevision restores title, description and content of the post
+// from an old revision. The restored version is saved as a new revision.
+func (server *Server) RestorePostRevision(ctx context.Context, request *pb.RestorePostRevisionRequest) (*pb.RestorePostRevisionResponse, error) {
+	violations := validateRestorePostRevisionRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.authorizePostAuthor(ctx, request.GetPostId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.RestorePostRevisionTx(ctx, db.RestorePostRevisionTxParams{
+		PostID:   request.GetPostId(),
+		Revision: request.GetRevision(),
+		EditorID: int32(authUser.ID),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "revision %d not found", request.GetRevision())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore post revision: %s", err)
+	}
+
+	post, err := server.store.GetPostByID(ctx, request.GetPostId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+
+	tags, err := server.store.GetTagsOfPost(ctx, post.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tags of post: %s", err)
+	}
+
+	res := &pb.RestorePostRevisionResponse{
+		Post:     convertPost(post, tags),
+		Revision: result.Revision.Revision,
+	}
+
+	return res, nil
+}
+
+// validateRestorePostRevisionRequest validates all the fields of the restore post revision request.
+func validateRestorePostRevisionRequest(request *pb.RestorePostRevisionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetPostId()); err != nil {
+		violations = append(violations, fieldViolation("post_id", err))
+	}
+
+	if err := validation.ValidateRevision(request.GetRevision()); err != nil {
+		violations = append(violations, fieldViolation("revision", err))
+	}
+
+	return violations
+}
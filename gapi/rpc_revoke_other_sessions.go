@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeOtherSessions blocks all sessions of the authenticated user
+// except the current one (the session of the given refresh token).
+func (server *Server) RevokeOtherSessions(ctx context.Context, request *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeOtherSessionsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.GetRefreshToken())
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	// only an active session of the authenticated user can revoke the others
+	if session.Email != authPayload.Email || session.RefreshToken != request.GetRefreshToken() {
+		return nil, status.Errorf(codes.PermissionDenied, "session does not belong to the authenticated user")
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "blocked session")
+	}
+
+	revoked, err := server.store.BlockOtherSessions(ctx, db.BlockOtherSessionsParams{
+		Email:           authPayload.Email,
+		CurrentFamilyID: session.FamilyID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %s", err)
+	}
+
+	return &pb.RevokeOtherSessionsResponse{RevokedSessions: revoked}, nil
+}
+
+// validateRevokeOtherSessionsRequest validates all the fields of the revoke other sessions request.
+func validateRevokeOtherSessionsRequest(request *pb.RevokeOtherSessionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateRefreshToken(request.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeSession blocks a session (with its whole family) of the authenticated user, so its refresh token can no longer be used.
+func (server *Server) RevokeSession(ctx context.Context, request *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeSessionRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	blocked, err := server.store.BlockSession(ctx, db.BlockSessionParams{
+		ID:    uuid.MustParse(request.GetId()),
+		Email: authPayload.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %s", err)
+	}
+
+	if blocked == 0 {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+// validateRevokeSessionRequest validates all the fields of the revoke session request.
+func validateRevokeSessionRequest(request *pb.RevokeSessionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateUUID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchPosts runs a full-text search over posts titles, descriptions
+// and contents. Results are ordered by rank and contain highlighted snippets,
+// which are HTML-escaped with the matches wrapped in <mark> tags.
+func (server *Server) SearchPosts(ctx context.Context, request *pb.SearchPostsRequest) (*pb.SearchPostsResponse, error) {
+	violations := validateSearchPostsRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	total, err := server.store.CountSearchPosts(ctx, request.GetQuery())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count search results: %s", err)
+	}
+
+	posts, err := server.store.SearchPosts(ctx, db.SearchPostsParams{
+		Query:      request.GetQuery(),
+		PageLimit:  request.GetPageSize(),
+		PageOffset: (request.GetPage() - 1) * request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search posts: %s", err)
+	}
+
+	res := &pb.SearchPostsResponse{
+		Total: total,
+		Posts: make([]*pb.PostSearchResult, len(posts)),
+	}
+	for i, post := range posts {
+		res.Posts[i] = convertPostSearchResult(post)
+	}
+
+	return res, nil
+}
+
+// validateSearchPostsRequest validates all the fields of the search posts request.
+func validateSearchPostsRequest(request *pb.SearchPostsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateSearchQuery(request.GetQuery()); err != nil {
+		violations = append(violations, fieldViolation("query", err))
+	}
+
+	if err := validation.ValidatePage(request.GetPage()); err != nil {
+		violations = append(violations, fieldViolation("page", err))
+	}
+
+	if err := validation.ValidatePageSize(request.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateCategory renames a category
+func (server *Server) UpdateCategory(ctx context.Context, request *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateCategoryRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	category, err := server.store.UpdateCategory(ctx, db.UpdateCategoryParams{
+		Name:   request.GetOldName(),
+		Name_2: request.GetNewName(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "category already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update category: %s", err)
+	}
+
+	res := &pb.UpdateCategoryResponse{
+		Category: convertCategory(category),
+	}
+
+	return res, nil
+}
+
+// validateUpdateCategoryRequest validates all the fields of the update category request.
+func validateUpdateCategoryRequest(request *pb.UpdateCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateCategoryName(request.GetOldName()); err != nil {
+		violations = append(violations, fieldViolation("old_name", err))
+	}
+
+	if err := validation.ValidateCategoryName(request.GetNewName()); err != nil {
+		violations = append(violations, fieldViolation("new_name", err))
+	}
+
+	return violations
+}
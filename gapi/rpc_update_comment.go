@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateComment updates a comment. Only the author of the comment is allowed to update it.
+func (server *Server) UpdateComment(ctx context.Context, request *pb.UpdateCommentRequest) (*pb.UpdateCommentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateCommentRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// get the comment to check if it exists and
+	// if the authenticated user is the author
+	comment, err := server.store.GetComment(ctx, request.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "comment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get comment: %s", err)
+	}
+
+	if comment.IsDeleted {
+		return nil, status.Errorf(codes.NotFound, "comment has been deleted")
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	if comment.UserID != int32(authUser.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "comment does not belong to the authenticated user")
+	}
+
+	params := db.UpdateCommentParams{
+		ID:      request.GetId(),
+		Content: request.GetContent(),
+	}
+
+	// the new content goes back to the moderation queue if it breaks the rules
+	if commentStatus, reason := server.config.CommentRules().CheckContent(request.GetContent()); commentStatus != utils.CommentStatusApproved {
+		params.Status = db.NullCommentStatus{CommentStatus: db.CommentStatus(commentStatus), Valid: true}
+		params.ModerationReason = sql.NullString{String: reason, Valid: true}
+	}
+
+	updatedComment, err := server.store.UpdateComment(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update comment: %s", err)
+	}
+
+	res := &pb.UpdateCommentResponse{
+		Comment: convertComment(updatedComment, authUser.Username),
+	}
+
+	return res, nil
+}
+
+// validateUpdateCommentRequest validates all the fields of the update comment request.
+func validateUpdateCommentRequest(request *pb.UpdateCommentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := validation.ValidateCommentContent(request.GetContent()); err != nil {
+		violations = append(violations, fieldViolation("content", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,181 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// UpdatePost updates a post with provided details. Only the author
+// of the post is allowed to update it.
+func (server *Server) UpdatePost(ctx context.Context, request *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdatePostRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authUser, err := server.store.GetUser(ctx, authPayload.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting user: %s", err)
+	}
+
+	// check if the user making the request is the author of the post
+	p, err := server.store.GetMinimalPostData(ctx, request.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+	if p.AuthorID != int32(authUser.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "post does not belong to the authenticated user")
+	}
+
+	post, err := server.store.GetPostByID(ctx, request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %s", err)
+	}
+
+	categoryName := post.CategoryName
+	if request.Category != nil {
+		categoryName = request.GetCategory()
+	}
+	categoryID, err := server.store.GetOrCreateCategory(ctx, categoryName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get or create category: %s", err)
+	}
+
+	params := db.UpdatePostParams{
+		ID:          request.GetId(),
+		Title:       post.Title,
+		Description: post.Description,
+		Content:     post.Content,
+		CategoryID:  int32(categoryID),
+		Image:       post.Image,
+		UpdatedAt:   time.Now(),
+	}
+	if request.Title != nil {
+		params.Title = request.GetTitle()
+	}
+	if request.Description != nil {
+		params.Description = request.GetDescription()
+	}
+	if request.Content != nil {
+		params.Content = request.GetContent()
+	}
+	if request.Image != nil {
+		params.Image = request.GetImage()
+	}
+
+	txParams := db.UpdatePostTxParams{
+		UpdatePostParams: params,
+		EditorID:         int32(authUser.ID),
+	}
+	if request.Status != nil {
+		txParams.Status = db.NullPostStatus{PostStatus: db.PostStatus(request.GetStatus()), Valid: true}
+		if request.PublishAt != nil {
+			txParams.PublishAt = sql.NullTime{Time: request.GetPublishAt().AsTime(), Valid: true}
+		}
+	}
+	if len(request.GetTags()) > 0 {
+		txParams.Tags = request.GetTags()
+	}
+
+	// every update is recorded as a new revision of the post,
+	// the status and the tags are changed in the same transaction
+	result, err := server.store.UpdatePostTx(ctx, txParams)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update post: %s", err)
+	}
+	updatedPost := result.Post
+
+	res := &pb.UpdatePostResponse{
+		Post: convertPost(db.GetPostByIDRow{
+			ID:             updatedPost.ID,
+			Title:          updatedPost.Title,
+			Description:    updatedPost.Description,
+			Content:        updatedPost.Content,
+			AuthorUsername: authUser.Username,
+			CategoryName:   categoryName,
+			Image:          updatedPost.Image,
+			Status:         updatedPost.Status,
+			PublishAt:      updatedPost.PublishAt,
+			CreatedAt:      updatedPost.CreatedAt,
+		}, result.Tags),
+	}
+
+	return res, nil
+}
+
+// validateUpdatePostRequest validates all the fields of the update post request.
+func validateUpdatePostRequest(request *pb.UpdatePostRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateID(request.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if request.Title != nil {
+		if err := validation.ValidatePostTitle(request.GetTitle()); err != nil {
+			violations = append(violations, fieldViolation("title", err))
+		}
+	}
+
+	if request.Description != nil {
+		if err := validation.ValidatePostDescription(request.GetDescription()); err != nil {
+			violations = append(violations, fieldViolation("description", err))
+		}
+	}
+
+	if request.Content != nil {
+		if err := validation.ValidatePostContent(request.GetContent()); err != nil {
+			violations = append(violations, fieldViolation("content", err))
+		}
+	}
+
+	if len(request.GetTags()) > 0 {
+		if err := validation.ValidateTags(request.GetTags()); err != nil {
+			violations = append(violations, fieldViolation("tags", err))
+		}
+	}
+
+	if request.Category != nil {
+		if err := validation.ValidateCategoryName(request.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	if request.Image != nil {
+		if err := validation.ValidateImage(request.GetImage()); err != nil {
+			violations = append(violations, fieldViolation("image", err))
+		}
+	}
+
+	if request.Status != nil {
+		if err := validation.ValidatePostStatus(request.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	if request.Status != nil || request.PublishAt != nil {
+		var publishAt time.Time
+		if request.PublishAt != nil {
+			publishAt = request.GetPublishAt().AsTime()
+		}
+		if err := validation.ValidatePostSchedule(request.GetStatus(), publishAt); err != nil {
+			violations = append(violations, fieldViolation("publish_at", err))
+		}
+	}
+
+	return violations
+}
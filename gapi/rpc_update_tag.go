@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateTag renames a tag
+func (server *Server) UpdateTag(ctx context.Context, request *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateTagRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tag, err := server.store.UpdateTag(ctx, db.UpdateTagParams{
+		Name:   request.GetOldName(),
+		Name_2: request.GetNewName(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "tag not found")
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "tag already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update tag: %s", err)
+	}
+
+	res := &pb.UpdateTagResponse{
+		Tag: convertTag(tag),
+	}
+
+	return res, nil
+}
+
+// validateUpdateTagRequest validates all the fields of the update tag request.
+func validateUpdateTagRequest(request *pb.UpdateTagRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateTagName(request.GetOldName()); err != nil {
+		violations = append(violations, fieldViolation("old_name", err))
+	}
+
+	if err := validation.ValidateTagName(request.GetNewName()); err != nil {
+		violations = append(violations, fieldViolation("new_name", err))
+	}
+
+	return violations
+}
@@ -15,9 +15,9 @@ import (
 
 // UpdateUser creates a new user
 func (server *Server) UpdateUser(ctx context.Context, request *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx)
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
 	if err != nil {
-		return nil, unauthenticatedError(err)
+		return nil, err
 	}
 
 	violations := validateUpdateUserRequest(request)
@@ -50,9 +50,10 @@ func (server *Server) UpdateUser(ctx context.Context, request *pb.UpdateUserRequ
 			Valid:  true,
 		}
 
-		// update the password changed at value
+		// update the password changed at value, truncated like the issue time of the tokens
+		// instead of rounded by the database, so tokens issued after the change are not revoked
 		params.PasswordChangedAt = sql.NullTime{
-			Time:  time.Now(),
+			Time:  time.Now().Truncate(time.Microsecond),
 			Valid: true,
 		}
 	}
@@ -65,6 +66,11 @@ func (server *Server) UpdateUser(ctx context.Context, request *pb.UpdateUserRequ
 		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
 
+	// tokens issued before the password change are rejected from now on
+	if request.Password != nil {
+		server.passwordChanges.Invalidate(user.Email)
+	}
+
 	res := &pb.UpdateUserResponse{
 		User: convertUser(user),
 	}
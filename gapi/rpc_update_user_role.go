@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateUserRole changes the role of a user. Only admins are allowed to do it.
+func (server *Server) UpdateUserRole(ctx context.Context, request *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	_, err := server.authorizeUser(ctx, utils.AdminRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateUserRoleRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		Email: request.GetEmail(),
+		Role:  db.UserRole(request.GetRole()),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update user role: %s", err)
+	}
+
+	server.passwordChanges.Invalidate(user.Email)
+
+	res := &pb.UpdateUserRoleResponse{
+		User: convertUser(user),
+	}
+
+	return res, nil
+}
+
+// validateUpdateUserRoleRequest validates all the fields of the request.
+func validateUpdateUserRoleRequest(request *pb.UpdateUserRoleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateEmail(request.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	if err := validation.ValidateRole(request.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// VerifyTwoFactor enables the two-factor authentication of the authenticated user
+// after the user confirms a TOTP code generated with the enrolled secret.
+func (server *Server) VerifyTwoFactor(ctx context.Context, request *pb.VerifyTwoFactorRequest) (*pb.VerifyTwoFactorResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, utils.ReaderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateVerifyTwoFactorRequest(request)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	twoFactorSecret, err := server.store.GetTwoFactorSecret(ctx, authPayload.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "two-factor authentication is not enrolled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor secret: %s", err)
+	}
+
+	if twoFactorSecret.IsEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	step, ok := utils.TOTPCodeStep(request.GetCode(), twoFactorSecret.Secret, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor code")
+	}
+
+	// the code cannot be used again to log in
+	_, err = server.store.EnableTwoFactorSecret(ctx, db.EnableTwoFactorSecretParams{
+		Email:        authPayload.Email,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to enable two-factor authentication: %s", err)
+	}
+
+	return &pb.VerifyTwoFactorResponse{}, nil
+}
+
+// validateVerifyTwoFactorRequest validates all the fields of the verify two-factor request.
+func validateVerifyTwoFactorRequest(request *pb.VerifyTwoFactorRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := validation.ValidateTOTPCode(request.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
@@ -1,12 +1,18 @@
 package gapi
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/lockout"
 	"github.com/aalug/blog-go/pb"
+	"github.com/aalug/blog-go/ratelimit"
+	"github.com/aalug/blog-go/sso"
 	"github.com/aalug/blog-go/token"
 	"github.com/aalug/blog-go/utils"
 	"github.com/aalug/blog-go/worker"
+	"net"
 )
 
 // Server serves gRPC requests for the service
@@ -16,19 +22,45 @@ type Server struct {
 	store           db.Store
 	tokenMaker      token.Maker
 	taskDistributor worker.TaskDistributor
+	passwordChanges *token.PasswordChangeCache
+	oidcProvider    *sso.Provider
+	loginLimiter    *lockout.Limiter
+	rateLimiter     *ratelimit.Limiter
+	trustedProxies  []*net.IPNet
 }
 
 // NewServer creates a new gRPC server
 func NewServer(config utils.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker:  %w", err)
 	}
+
+	rateLimiter, err := ratelimit.NewLimiterFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate limiter: %w", err)
+	}
+
+	trustedProxies, err := utils.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse trusted proxies: %w", err)
+	}
+
 	server := &Server{
 		config:          config,
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributor: taskDistributor,
+		passwordChanges: token.NewPasswordChangeCache(store, config.PasswordChangeCacheDuration),
+		loginLimiter:    lockout.NewLimiter(store, config),
+		rateLimiter:     rateLimiter,
+		trustedProxies:  trustedProxies,
+	}
+
+	// OIDC login is optional, its RPCs return NotFound if it is not configured
+	server.oidcProvider, err = sso.NewProvider(context.Background(), config)
+	if err != nil && !errors.Is(err, sso.ErrNotConfigured) {
+		return nil, fmt.Errorf("cannot create OIDC provider: %w", err)
 	}
 
 	return server, nil
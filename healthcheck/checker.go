@@ -0,0 +1,156 @@
+// Package healthcheck reports the liveness and the readiness of the app
+// to orchestrators, over HTTP (/healthz, /readyz) and the gRPC health service
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusUnavailable  = "unavailable"
+	StatusShuttingDown = "shutting down"
+)
+
+// Check - a check of a dependency the app needs to serve requests, e.g. a ping of the database
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Report - the result of the readiness checks. Checks maps the names of the checks
+// to "ok" or the errors of the failed checks.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Ready reports whether all the checks passed
+func (report Report) Ready() bool {
+	return report.Status == StatusOK
+}
+
+// Checker - runs the readiness checks. Each run of the checks gets the timeout.
+type Checker struct {
+	timeout      time.Duration
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+// NewChecker creates a new Checker
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+// Add adds a readiness check. Checks must be added before the checker is used.
+func (checker *Checker) Add(name string, check Check) {
+	checker.checks = append(checker.checks, namedCheck{name: name, check: check})
+}
+
+// Shutdown makes the app not ready, so the orchestrator stops sending requests during the graceful shutdown
+func (checker *Checker) Shutdown() {
+	checker.shuttingDown.Store(true)
+}
+
+// Check runs all the checks concurrently
+func (checker *Checker) Check(ctx context.Context) Report {
+	if checker.shuttingDown.Load() {
+		return Report{Status: StatusShuttingDown}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checker.timeout)
+	defer cancel()
+
+	report := Report{
+		Status: StatusOK,
+		Checks: make(map[string]string, len(checker.checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range checker.checks {
+		wg.Add(1)
+		go func(c namedCheck) {
+			defer wg.Done()
+
+			result := StatusOK
+			if err := c.check(ctx); err != nil {
+				result = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[c.name] = result
+			if result != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// LivenessHandler returns the handler of /healthz, which responds with 200 as long as the process serves HTTP
+func (checker *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, Report{Status: StatusOK})
+	})
+}
+
+// ReadinessHandler returns the handler of /readyz, which runs the checks and responds
+// with 200 if all of them passed or 503 otherwise, with the report in the body
+func (checker *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checker.Check(r.Context())
+
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeReport(w, code, report)
+	})
+}
+
+// Watch runs the checks every interval until ctx is done and sets the serving status
+// of the services (with "" for the whole server) in the gRPC health server
+func (checker *Checker) Watch(ctx context.Context, healthServer *health.Server, interval time.Duration, services ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if !checker.Check(ctx).Ready() {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		for _, service := range services {
+			healthServer.SetServingStatus(service, servingStatus)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
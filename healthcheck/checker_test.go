@@ -0,0 +1,131 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func passingCheck(context.Context) error {
+	return nil
+}
+
+func failingCheck(context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestChecker_Check(t *testing.T) {
+	testCases := []struct {
+		name          string
+		checks        map[string]Check
+		shutdown      bool
+		checkResponse func(t *testing.T, report Report)
+	}{
+		{
+			name:   "All Checks Pass",
+			checks: map[string]Check{"postgres": passingCheck, "redis": passingCheck},
+			checkResponse: func(t *testing.T, report Report) {
+				require.True(t, report.Ready())
+				require.Equal(t, map[string]string{"postgres": StatusOK, "redis": StatusOK}, report.Checks)
+			},
+		},
+		{
+			name:   "Check Fails",
+			checks: map[string]Check{"postgres": passingCheck, "redis": failingCheck},
+			checkResponse: func(t *testing.T, report Report) {
+				require.False(t, report.Ready())
+				require.Equal(t, StatusUnavailable, report.Status)
+				require.Equal(t, map[string]string{"postgres": StatusOK, "redis": "connection refused"}, report.Checks)
+			},
+		},
+		{
+			name: "Check Times Out",
+			checks: map[string]Check{"postgres": func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}},
+			checkResponse: func(t *testing.T, report Report) {
+				require.False(t, report.Ready())
+				require.Equal(t, context.DeadlineExceeded.Error(), report.Checks["postgres"])
+			},
+		},
+		{
+			name:     "Shutting Down",
+			checks:   map[string]Check{"postgres": passingCheck},
+			shutdown: true,
+			checkResponse: func(t *testing.T, report Report) {
+				require.False(t, report.Ready())
+				require.Equal(t, StatusShuttingDown, report.Status)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(50 * time.Millisecond)
+			for name, check := range tc.checks {
+				checker.Add(name, check)
+			}
+			if tc.shutdown {
+				checker.Shutdown()
+			}
+
+			tc.checkResponse(t, checker.Check(context.Background()))
+		})
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("postgres", passingCheck)
+
+	recorder := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	checker.Add("redis", failingCheck)
+
+	recorder = httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	var report Report
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&report))
+	require.Equal(t, "connection refused", report.Checks["redis"])
+
+	// the app is alive even if it is not ready
+	recorder = httptest.NewRecorder()
+	checker.LivenessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestChecker_Watch(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("redis", failingCheck)
+
+	healthServer := health.NewServer()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		checker.Watch(ctx, healthServer, time.Hour, "", "pb.BlogGo")
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		res, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "pb.BlogGo"})
+		return err == nil && res.Status == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,50 @@
+package healthcheck
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+)
+
+// PingDB checks that the database is reachable
+func PingDB(conn *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return conn.PingContext(ctx)
+	}
+}
+
+// PingRedis checks that Redis is reachable
+func PingRedis(client redis.UniversalClient) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+// MigrationsCurrent checks that the database schema is migrated to the version
+// expected by the app and that the last migration did not fail (the schema is not dirty).
+// The version is read from the schema_migrations table of golang-migrate.
+func MigrationsCurrent(conn *sql.DB, expectedVersion uint) Check {
+	return func(ctx context.Context) error {
+		var version uint
+		var dirty bool
+		err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("no migrations applied")
+			}
+			return err
+		}
+
+		if dirty {
+			return fmt.Errorf("migration %d failed, the schema is dirty", version)
+		}
+
+		if version != expectedVersion {
+			return fmt.Errorf("schema version is %d, expected %d", version, expectedVersion)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,188 @@
+package lockout
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/utils"
+	"math"
+	"time"
+)
+
+const (
+	accountKeyPrefix = "account:"
+	ipKeyPrefix      = "ip:"
+)
+
+// Store - the queries used by the Limiter to keep the failed login attempts
+type Store interface {
+	GetLoginAttempts(ctx context.Context, keys []string) ([]db.LoginAttempt, error)
+	RecordFailedLoginAttempt(ctx context.Context, arg db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error)
+	LockLoginAttempt(ctx context.Context, arg db.LockLoginAttemptParams) error
+	DeleteLoginAttempt(ctx context.Context, key string) error
+}
+
+// Failure - the result of recording a failed login attempt
+type Failure struct {
+	// AccountLocked is true if the attempt has locked the account. It happens once
+	// per attempt window, so the owner of the account can be notified about it.
+	AccountLocked bool
+	LockedUntil   time.Time
+}
+
+// Limiter limits failed login attempts per account and per client IP.
+// After the max number of failed attempts within the attempt window, every next
+// failed attempt locks the account (or the IP) for twice as long as the previous one,
+// starting with the lockout duration and up to the max lockout duration (without
+// the max, the lockout duration does not grow). A limit of 0 disables limiting
+// by the account or by the IP.
+type Limiter struct {
+	store              Store
+	maxAttempts        int
+	maxAttemptsPerIP   int
+	window             time.Duration
+	lockoutDuration    time.Duration
+	maxLockoutDuration time.Duration
+}
+
+// NewLimiter creates a new Limiter with the login limits from the configuration
+func NewLimiter(store Store, config utils.Config) *Limiter {
+	return &Limiter{
+		store:              store,
+		maxAttempts:        config.LoginMaxAttempts,
+		maxAttemptsPerIP:   config.LoginMaxAttemptsPerIP,
+		window:             config.LoginAttemptWindow,
+		lockoutDuration:    config.LoginLockoutDuration,
+		maxLockoutDuration: config.LoginMaxLockoutDuration,
+	}
+}
+
+// Check returns how long the client must wait before the next login attempt
+// to the account, zero if the account and the client IP are not locked
+func (limiter *Limiter) Check(ctx context.Context, email, clientIP string) (time.Duration, error) {
+	var keys []string
+	if limiter.maxAttempts > 0 && email != "" {
+		keys = append(keys, accountKey(email))
+	}
+	if limiter.maxAttemptsPerIP > 0 && clientIP != "" {
+		keys = append(keys, ipKey(clientIP))
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	attempts, err := limiter.store.GetLoginAttempts(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	var retryAfter time.Duration
+	for _, attempt := range attempts {
+		if attempt.LockedUntil.Valid && attempt.LockedUntil.Time.Sub(now) > retryAfter {
+			retryAfter = attempt.LockedUntil.Time.Sub(now)
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// Fail records a failed login attempt to the account from the client IP.
+// The email should be empty if the account does not exist, so only the IP is limited.
+func (limiter *Limiter) Fail(ctx context.Context, email, clientIP string) (Failure, error) {
+	var failure Failure
+
+	if limiter.maxAttempts > 0 && email != "" {
+		lockedUntil, attempts, err := limiter.record(ctx, accountKey(email), limiter.maxAttempts)
+		if err != nil {
+			return Failure{}, err
+		}
+		failure.AccountLocked = attempts == limiter.maxAttempts
+		failure.LockedUntil = lockedUntil
+	}
+
+	if limiter.maxAttemptsPerIP > 0 && clientIP != "" {
+		lockedUntil, _, err := limiter.record(ctx, ipKey(clientIP), limiter.maxAttemptsPerIP)
+		if err != nil {
+			return Failure{}, err
+		}
+		if lockedUntil.After(failure.LockedUntil) {
+			failure.LockedUntil = lockedUntil
+		}
+	}
+
+	return failure, nil
+}
+
+// Succeed forgets the failed login attempts to the account after a successful login.
+// The attempts from the client IP are kept, so logging in to one account
+// does not allow guessing passwords of other accounts.
+func (limiter *Limiter) Succeed(ctx context.Context, email string) error {
+	if limiter.maxAttempts <= 0 {
+		return nil
+	}
+
+	return limiter.store.DeleteLoginAttempt(ctx, accountKey(email))
+}
+
+// record records a failed attempt of the key and locks the key if the limit is reached.
+// Returns the time until the key is locked (zero if it is not) and the number of failed attempts.
+func (limiter *Limiter) record(ctx context.Context, key string, limit int) (time.Time, int, error) {
+	now := time.Now()
+	attempt, err := limiter.store.RecordFailedLoginAttempt(ctx, db.RecordFailedLoginAttemptParams{
+		Key:         key,
+		FailedAt:    now,
+		ResetBefore: now.Add(-limiter.window),
+	})
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	attempts := int(attempt.FailedAttempts)
+	if attempts < limit {
+		return time.Time{}, attempts, nil
+	}
+
+	lockedUntil := now.Add(limiter.lockDuration(attempts - limit))
+	err = limiter.store.LockLoginAttempt(ctx, db.LockLoginAttemptParams{
+		Key: key,
+		LockedUntil: sql.NullTime{
+			Time:  lockedUntil,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	return lockedUntil, attempts, nil
+}
+
+// lockDuration returns the lockout duration doubled for every failed attempt over the limit
+func (limiter *Limiter) lockDuration(overLimit int) time.Duration {
+	duration := limiter.lockoutDuration
+	for i := 0; i < overLimit && duration < limiter.maxLockoutDuration; i++ {
+		duration *= 2
+	}
+
+	if limiter.maxLockoutDuration > 0 && duration > limiter.maxLockoutDuration {
+		return limiter.maxLockoutDuration
+	}
+
+	return duration
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header for the duration
+func RetryAfterSeconds(retryAfter time.Duration) int {
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+func accountKey(email string) string {
+	return accountKeyPrefix + email
+}
+
+// ipKey returns the key of the client IP. The client IP must be resolved with utils.ClientIP
+// (or gin with the trusted proxies), so it cannot be spoofed.
+func ipKey(clientIP string) string {
+	return ipKeyPrefix + utils.NormalizeIP(clientIP)
+}
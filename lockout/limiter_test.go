@@ -0,0 +1,182 @@
+package lockout
+
+import (
+	"context"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// fakeStore keeps the login attempts in memory, like the login_attempts table
+type fakeStore struct {
+	attempts map[string]db.LoginAttempt
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{attempts: make(map[string]db.LoginAttempt)}
+}
+
+func (store *fakeStore) GetLoginAttempts(_ context.Context, keys []string) ([]db.LoginAttempt, error) {
+	var attempts []db.LoginAttempt
+	for _, key := range keys {
+		if attempt, ok := store.attempts[key]; ok {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts, nil
+}
+
+func (store *fakeStore) RecordFailedLoginAttempt(_ context.Context, arg db.RecordFailedLoginAttemptParams) (db.LoginAttempt, error) {
+	attempt, ok := store.attempts[arg.Key]
+	if !ok || attempt.LastFailedAt.Before(arg.ResetBefore) {
+		attempt = db.LoginAttempt{Key: arg.Key, LockedUntil: attempt.LockedUntil}
+	}
+	attempt.FailedAttempts++
+	attempt.LastFailedAt = arg.FailedAt
+	store.attempts[arg.Key] = attempt
+	return attempt, nil
+}
+
+func (store *fakeStore) LockLoginAttempt(_ context.Context, arg db.LockLoginAttemptParams) error {
+	attempt := store.attempts[arg.Key]
+	attempt.LockedUntil = arg.LockedUntil
+	store.attempts[arg.Key] = attempt
+	return nil
+}
+
+func (store *fakeStore) DeleteLoginAttempt(_ context.Context, key string) error {
+	delete(store.attempts, key)
+	return nil
+}
+
+func newTestLimiter(store Store) *Limiter {
+	return NewLimiter(store, utils.Config{
+		LoginMaxAttempts:        3,
+		LoginMaxAttemptsPerIP:   5,
+		LoginAttemptWindow:      time.Hour,
+		LoginLockoutDuration:    time.Minute,
+		LoginMaxLockoutDuration: 5 * time.Minute,
+	})
+}
+
+func TestLimiter_AccountLockout(t *testing.T) {
+	store := newFakeStore()
+	limiter := newTestLimiter(store)
+	email := utils.RandomEmail()
+	ctx := context.Background()
+
+	for i := 1; i < 3; i++ {
+		failure, err := limiter.Fail(ctx, email, "")
+		require.NoError(t, err)
+		require.False(t, failure.AccountLocked)
+		require.True(t, failure.LockedUntil.IsZero())
+	}
+
+	retryAfter, err := limiter.Check(ctx, email, "")
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	// the 3rd failed attempt locks the account
+	failure, err := limiter.Fail(ctx, email, "")
+	require.NoError(t, err)
+	require.True(t, failure.AccountLocked)
+	require.WithinDuration(t, time.Now().Add(time.Minute), failure.LockedUntil, time.Second)
+
+	retryAfter, err = limiter.Check(ctx, email, "")
+	require.NoError(t, err)
+	require.InDelta(t, time.Minute, retryAfter, float64(time.Second))
+
+	// the next failed attempts double the lockout, but notify only once
+	failure, err = limiter.Fail(ctx, email, "")
+	require.NoError(t, err)
+	require.False(t, failure.AccountLocked)
+	require.WithinDuration(t, time.Now().Add(2*time.Minute), failure.LockedUntil, time.Second)
+
+	// a successful login forgets the attempts
+	require.NoError(t, limiter.Succeed(ctx, email))
+	retryAfter, err = limiter.Check(ctx, email, "")
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+}
+
+func TestLimiter_IPLockout(t *testing.T) {
+	store := newFakeStore()
+	limiter := newTestLimiter(store)
+	ctx := context.Background()
+
+	// attempts to unknown accounts are limited by the IP, the port is ignored
+	for i := 0; i < 5; i++ {
+		_, err := limiter.Fail(ctx, "", "10.0.0.1:1234")
+		require.NoError(t, err)
+	}
+
+	retryAfter, err := limiter.Check(ctx, utils.RandomEmail(), "10.0.0.1:5678")
+	require.NoError(t, err)
+	require.Greater(t, retryAfter, time.Duration(0))
+
+	retryAfter, err = limiter.Check(ctx, utils.RandomEmail(), "10.0.0.2")
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	// a successful login does not forget the attempts from the IP
+	require.NoError(t, limiter.Succeed(ctx, utils.RandomEmail()))
+	retryAfter, err = limiter.Check(ctx, "", "10.0.0.1")
+	require.NoError(t, err)
+	require.Greater(t, retryAfter, time.Duration(0))
+}
+
+func TestLimiter_Window(t *testing.T) {
+	store := newFakeStore()
+	limiter := newTestLimiter(store)
+	email := utils.RandomEmail()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := limiter.Fail(ctx, email, "")
+		require.NoError(t, err)
+	}
+
+	// attempts older than the window are forgotten
+	attempt := store.attempts[accountKey(email)]
+	attempt.LastFailedAt = time.Now().Add(-2 * time.Hour)
+	store.attempts[accountKey(email)] = attempt
+
+	failure, err := limiter.Fail(ctx, email, "")
+	require.NoError(t, err)
+	require.False(t, failure.AccountLocked)
+	require.EqualValues(t, 1, store.attempts[accountKey(email)].FailedAttempts)
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	limiter := NewLimiter(nil, utils.Config{})
+	ctx := context.Background()
+
+	// the store is not used at all
+	retryAfter, err := limiter.Check(ctx, utils.RandomEmail(), "10.0.0.1")
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	failure, err := limiter.Fail(ctx, utils.RandomEmail(), "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, Failure{}, failure)
+
+	require.NoError(t, limiter.Succeed(ctx, utils.RandomEmail()))
+}
+
+func TestLimiter_lockDuration(t *testing.T) {
+	limiter := newTestLimiter(nil)
+
+	require.Equal(t, time.Minute, limiter.lockDuration(0))
+	require.Equal(t, 2*time.Minute, limiter.lockDuration(1))
+	require.Equal(t, 4*time.Minute, limiter.lockDuration(2))
+	require.Equal(t, 5*time.Minute, limiter.lockDuration(3))
+	require.Equal(t, 5*time.Minute, limiter.lockDuration(1000))
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	require.Equal(t, 1, RetryAfterSeconds(time.Millisecond))
+	require.Equal(t, 60, RetryAfterSeconds(time.Minute))
+	require.Equal(t, 61, RetryAfterSeconds(time.Minute+time.Millisecond))
+}
@@ -2,27 +2,69 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/aalug/blog-go/api"
+	"github.com/aalug/blog-go/db/migrations"
 	db "github.com/aalug/blog-go/db/sqlc"
 	_ "github.com/aalug/blog-go/docs/statik"
 	"github.com/aalug/blog-go/gapi"
+	"github.com/aalug/blog-go/healthcheck"
 	"github.com/aalug/blog-go/mail"
+	"github.com/aalug/blog-go/metrics"
 	"github.com/aalug/blog-go/pb"
 	"github.com/aalug/blog-go/utils"
 	"github.com/aalug/blog-go/worker"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
 	"github.com/rakyll/statik/fs"
+	"github.com/redis/go-redis/v9"
 	zerolog "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"time"
 )
 
+// interruptSignals are the signals that start the graceful shutdown
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+}
+
+// defaultShutdownTimeout is used when SHUTDOWN_TIMEOUT is not set
+const defaultShutdownTimeout = 10 * time.Second
+
+const (
+	readinessCheckTimeout  = 2 * time.Second
+	readinessCheckInterval = 5 * time.Second
+)
+
+const usage = `usage: blog-go [command]
+
+commands:
+  serve        start the servers and the worker (the default command), see serve -h
+  migrate      migrate the db, see migrate help
+  user         create, promote, disable and enable users, see user help
+  category     merge categories, see category help
+  tag          prune the unused tags, see tag help
+  session      revoke sessions, see session help`
+
 func main() {
 	// === env ===
 	config, err := utils.LoadConfig(".")
@@ -30,68 +72,189 @@ func main() {
 		log.Fatal("cannot load env file: ", err)
 	}
 
-	// === postgres ===
-	conn, err := sql.Open(config.DBDriver, config.DBSource)
-	if err != nil {
-		log.Fatal("cannot connect to the db: ", err)
+	// === subcommands ===
+	command, args := "serve", []string(nil)
+	if len(os.Args) > 1 {
+		command, args = os.Args[1], os.Args[2:]
 	}
 
-	store := db.NewStore(conn)
-
-	// === redis ===
-	redisOpt := asynq.RedisClientOpt{
-		Addr: config.RedisAddress,
+	switch command {
+	case "serve":
+		err = runServeCommand(config, args)
+	case "migrate":
+		err = runMigrateCommand(config, args)
+	case "user":
+		err = runAdminCommand(config, runUserCommand, args)
+	case "category":
+		err = runAdminCommand(config, runCategoryCommand, args)
+	case "tag":
+		err = runAdminCommand(config, runTagCommand, args)
+	case "session":
+		err = runAdminCommand(config, runSessionCommand, args)
+	case "help", "-h", "-help", "--help":
+		fmt.Println(usage)
+	default:
+		err = fmt.Errorf("unknown command %q\n\n%s", command, usage)
 	}
 
-	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-
-	//serverType := os.Getenv("SERVER_TYPE")
-	//if serverType == "gin" {
-	//	runGinServer(config, store)
-	//} else {
-	//	runGrpcServer(config, store)
-	//}
-	go runTaskProcessor(config, redisOpt, store)
-	go runGatewayServer(config, store, taskDistributor)
-	runGrpcServer(config, store, taskDistributor)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
-func runGinServer(config utils.Config, store db.Store) {
-	server, err := api.NewServer(config, store)
+// runGinServer starts serving the Gin HTTP server in the wait group and returns the server,
+// which is stopped with stopHTTPServer
+func runGinServer(
+	waitGroup *errgroup.Group,
+	config utils.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+	healthChecker *healthcheck.Checker,
+) *http.Server {
+	server, err := api.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal("cannot create server: ", err)
 	}
 
-	err = server.Start(config.HTTPServerAddress)
+	mux := http.NewServeMux()
+	mux.Handle("/", server.Handler())
+	mux.Handle("/healthz", healthChecker.LivenessHandler())
+	mux.Handle("/readyz", healthChecker.ReadinessHandler())
+
+	listener, err := net.Listen("tcp", config.HTTPServerAddress)
 	if err != nil {
-		log.Fatal("cannot start the server:", err)
+		log.Fatal("cannot create a listener:", err)
 	}
+
+	httpServer := &http.Server{
+		Handler: mux,
+	}
+
+	waitGroup.Go(func() error {
+		log.Printf("Gin HTTP server starting at %s", listener.Addr().String())
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("cannot start the Gin HTTP server: %w", err)
+		}
+
+		zerolog.Info().Msg("Gin HTTP server stopped")
+		return nil
+	})
+
+	return httpServer
 }
 
-func runGrpcServer(config utils.Config, store db.Store, taskDistributor worker.TaskDistributor) {
+// newHealthChecker creates the checker of the readiness of the app: Postgres, Redis
+// used by asynq and whether the database is migrated to the latest migration
+func newHealthChecker(config utils.Config, conn *sql.DB) *healthcheck.Checker {
+	migrationsVersion, err := migrations.LatestVersion()
+	if err != nil {
+		log.Fatal("cannot read the migrations: ", err)
+	}
+
+	healthChecker := healthcheck.NewChecker(readinessCheckTimeout)
+	healthChecker.Add("postgres", healthcheck.PingDB(conn))
+	healthChecker.Add("redis", healthcheck.PingRedis(redis.NewClient(&redis.Options{Addr: config.RedisAddress})))
+	healthChecker.Add("migrations", healthcheck.MigrationsCurrent(conn, migrationsVersion))
+
+	return healthChecker
+}
+
+// runGrpcServer starts serving gRPC in the wait group and returns the server, which is stopped with stopGrpcServer.
+// The gRPC health service reports the readiness of the app until ctx is done.
+func runGrpcServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config utils.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+	healthChecker *healthcheck.Checker,
+) *grpc.Server {
 	server, err := gapi.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal("cannot create server: ", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			server.GrpcLogger,
+			server.MetricsInterceptor,
+			server.RateLimitInterceptor,
+		),
+	}
+
+	// TLS is optional, e.g. it can be terminated by a load balancer
+	if config.GRPCTLSCertFile != "" || config.GRPCTLSKeyFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(config.GRPCTLSCertFile, config.GRPCTLSKeyFile)
+		if err != nil {
+			log.Fatal("cannot load the TLS certificate of the gRPC server: ", err)
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+
+	grpcServer := grpc.NewServer(options...)
 	pb.RegisterBlogGoServer(grpcServer, server)
 
 	reflection.Register(grpcServer)
 
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	waitGroup.Go(func() error {
+		healthChecker.Watch(ctx, healthServer, readinessCheckInterval, "", pb.BlogGo_ServiceDesc.ServiceName)
+		// the clients stop sending new RPCs while the server drains the in-flight ones
+		healthServer.Shutdown()
+		return nil
+	})
+
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
 	if err != nil {
 		log.Fatal("cannot create a listener:", err)
 	}
 
-	log.Printf("gRPC server listening at %s", listener.Addr().String())
-	err = grpcServer.Serve(listener)
-	if err != nil {
-		log.Fatal("cannot start the gRPC server:", err)
+	waitGroup.Go(func() error {
+		log.Printf("gRPC server listening at %s", listener.Addr().String())
+		err := grpcServer.Serve(listener)
+		if err != nil {
+			return fmt.Errorf("cannot start the gRPC server: %w", err)
+		}
+
+		zerolog.Info().Msg("gRPC server stopped")
+		return nil
+	})
+
+	return grpcServer
+}
+
+// stopGrpcServer stops accepting new RPCs and waits for the in-flight ones.
+// The RPCs that do not finish within the timeout are cancelled.
+func stopGrpcServer(grpcServer *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		zerolog.Warn().Msg("gRPC server did not stop in time, cancelling the in-flight requests")
+		grpcServer.Stop()
 	}
 }
 
-func runGatewayServer(config utils.Config, store db.Store, taskDistributor worker.TaskDistributor) {
+// runGatewayServer starts serving the HTTP gateway in the wait group and returns the server,
+// which is stopped with stopHTTPServer. The gateway forwards the requests to the gRPC server
+// at GATEWAY_GRPC_ENDPOINT, which can run in another process, until ctx is done.
+func runGatewayServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config utils.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+	healthChecker *healthcheck.Checker,
+) *http.Server {
 	server, err := gapi.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal("cannot create server: ", err)
@@ -106,18 +269,45 @@ func runGatewayServer(config utils.Config, store db.Store, taskDistributor worke
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	// the Retry-After header of ResourceExhausted errors is forwarded as is
+	headerOption := runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+		if key == "retry-after" {
+			return "Retry-After", true
+		}
+		return runtime.MetadataHeaderPrefix + key, true
+	})
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	// the request ID set by the HTTP logger is passed to the gRPC service
+	requestIDOption := runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if strings.EqualFold(key, gapi.RequestIDHeader) {
+			return strings.ToLower(key), true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+
+	grpcMux := runtime.NewServeMux(jsonOption, headerOption, requestIDOption)
+
+	endpoint := config.GatewayGRPCEndpoint
+	if endpoint == "" {
+		endpoint = config.GRPCServerAddress
+	}
+
+	dialOptions, err := gatewayDialOptions(config)
+	if err != nil {
+		log.Fatal("cannot create the gateway connection options: ", err)
+	}
 
-	err = pb.RegisterBlogGoHandlerServer(ctx, grpcMux, server)
+	err = pb.RegisterBlogGoHandlerFromEndpoint(ctx, grpcMux, endpoint, dialOptions)
 	if err != nil {
-		log.Fatal("cannot register handler server: ", err)
+		log.Fatal("cannot register handler from endpoint: ", err)
 	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.Handle("/.well-known/jwks.json", server.JWKSHandler())
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", healthChecker.LivenessHandler())
+	mux.Handle("/readyz", healthChecker.ReadinessHandler())
 
 	// swagger docs server
 	statikFileSystem, err := fs.New()
@@ -128,24 +318,172 @@ func runGatewayServer(config utils.Config, store db.Store, taskDistributor worke
 	docsHandler := http.StripPrefix("/docs/", http.FileServer(statikFileSystem))
 	mux.Handle("/docs/", docsHandler)
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
+	listener, err := net.Listen("tcp", gatewayServerAddress(config))
 	if err != nil {
 		log.Fatal("cannot create a listener:", err)
 	}
 
-	log.Printf("HTTP gateway server starting at %s", listener.Addr().String())
-	err = http.Serve(listener, mux)
+	// the trace of a request starts here and continues in the gRPC server
+	handler := otelhttp.NewHandler(server.HTTPLogger(mux), "gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+
+	httpServer := &http.Server{
+		Handler: handler,
+	}
+
+	waitGroup.Go(func() error {
+		log.Printf("HTTP gateway server starting at %s", listener.Addr().String())
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("cannot start the HTTP gateway server: %w", err)
+		}
+
+		zerolog.Info().Msg("HTTP gateway server stopped")
+		return nil
+	})
+
+	return httpServer
+}
+
+// gatewayDialOptions returns the options of the connection from the gateway to the gRPC server.
+// The connection uses TLS with GATEWAY_GRPC_TLS, verified with GATEWAY_GRPC_CA_FILE or the system roots.
+func gatewayDialOptions(config utils.Config) ([]grpc.DialOption, error) {
+	creds := insecure.NewCredentials()
+	if config.GatewayGRPCTLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		if config.GatewayGRPCCAFile != "" {
+			var err error
+			creds, err = credentials.NewClientTLSFromFile(config.GatewayGRPCCAFile, "")
+			if err != nil {
+				return nil, fmt.Errorf("cannot load the CA certificate: %w", err)
+			}
+		}
+	}
+
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	}
+
+	return options, nil
+}
+
+// gatewayServerAddress returns the address of the HTTP gateway, HTTP_SERVER_ADDRESS if GATEWAY_SERVER_ADDRESS is not set
+func gatewayServerAddress(config utils.Config) string {
+	if config.GatewayServerAddress != "" {
+		return config.GatewayServerAddress
+	}
+
+	return config.HTTPServerAddress
+}
+
+// runOpsServer starts serving /metrics, /healthz and /readyz at OPS_SERVER_ADDRESS in the wait group
+// and returns the server, which is stopped with stopHTTPServer.
+// It is meant for the processes without an HTTP server, e.g. running only the worker.
+func runOpsServer(waitGroup *errgroup.Group, config utils.Config, healthChecker *healthcheck.Checker) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", healthChecker.LivenessHandler())
+	mux.Handle("/readyz", healthChecker.ReadinessHandler())
+
+	listener, err := net.Listen("tcp", config.OpsServerAddress)
 	if err != nil {
-		log.Fatal("cannot start the HTTP gateway server:", err)
+		log.Fatal("cannot create a listener:", err)
 	}
+
+	httpServer := &http.Server{
+		Handler: mux,
+	}
+
+	waitGroup.Go(func() error {
+		log.Printf("ops server starting at %s", listener.Addr().String())
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("cannot start the ops server: %w", err)
+		}
+
+		zerolog.Info().Msg("ops server stopped")
+		return nil
+	})
+
+	return httpServer
 }
 
-func runTaskProcessor(config utils.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
-	emailSender := mail.NewHogSender(config.EmailSenderAddress)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, emailSender)
-	zerolog.Info().Msg("task processor started")
-	err := taskProcessor.Start()
+// stopHTTPServer stops accepting new requests and waits for the in-flight ones.
+// The connections that are still active after the timeout are closed.
+func stopHTTPServer(httpServer *http.Server, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := httpServer.Shutdown(ctx)
 	if err != nil {
-		zerolog.Fatal().Err(err).Msg("failed to start task processor")
+		zerolog.Warn().Err(err).Msg("HTTP server did not stop in time, closing the connections")
+		_ = httpServer.Close()
 	}
 }
+
+// runTaskProcessor starts the task processor, which is stopped with its Shutdown method
+func runTaskProcessor(
+	waitGroup *errgroup.Group,
+	config utils.Config,
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+) worker.TaskProcessor {
+	emailSender := mail.NewHogSender(config.EmailSenderAddress)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, emailSender, config.WorkerConcurrency, config.TaskShutdownTimeout)
+
+	// asynq processes the tasks in the background, Start returns right away
+	waitGroup.Go(func() error {
+		err := taskProcessor.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start task processor: %w", err)
+		}
+
+		zerolog.Info().Msg("task processor started")
+		return nil
+	})
+
+	return taskProcessor
+}
+
+// runOutboxRelay starts the outbox relay, which is stopped with its Shutdown method
+func runOutboxRelay(
+	waitGroup *errgroup.Group,
+	config utils.Config,
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+) worker.OutboxRelay {
+	outboxRelay := worker.NewRedisOutboxRelay(redisOpt, store, config.OutboxRelayInterval)
+
+	waitGroup.Go(func() error {
+		err := outboxRelay.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start outbox relay: %w", err)
+		}
+
+		zerolog.Info().Msg("outbox relay started")
+		return nil
+	})
+
+	return outboxRelay
+}
+
+// runTaskScheduler starts the task scheduler, which is stopped with its Shutdown method
+func runTaskScheduler(waitGroup *errgroup.Group, redisOpt asynq.RedisClientOpt) worker.TaskScheduler {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+
+	waitGroup.Go(func() error {
+		err := taskScheduler.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start task scheduler: %w", err)
+		}
+
+		zerolog.Info().Msg("task scheduler started")
+		return nil
+	})
+
+	return taskScheduler
+}
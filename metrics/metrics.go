@@ -0,0 +1,91 @@
+// Package metrics defines the Prometheus metrics of the app, which are exposed at /metrics
+package metrics
+
+import (
+	"database/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+const namespace = "blog"
+
+var (
+	// GRPCRequestsTotal counts the handled RPCs by the full method name and the status code
+	GRPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of handled gRPC requests.",
+	}, []string{"method", "code"})
+
+	// GRPCRequestDuration observes the latency of the RPCs by the full method name
+	GRPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// HTTPRequestsTotal counts the handled Gin requests by the method, the route and the status code
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of handled HTTP requests.",
+	}, []string{"method", "route", "code"})
+
+	// HTTPRequestDuration observes the latency of the Gin requests by the method and the route
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	// TasksEnqueuedTotal counts the tasks enqueued by the distributor by the task type
+	TasksEnqueuedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tasks",
+		Name:      "enqueued_total",
+		Help:      "Number of enqueued asynq tasks.",
+	}, []string{"type"})
+
+	// TasksEnqueueFailuresTotal counts the tasks the distributor failed to enqueue by the task type
+	TasksEnqueueFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tasks",
+		Name:      "enqueue_failures_total",
+		Help:      "Number of asynq tasks that failed to be enqueued.",
+	}, []string{"type"})
+
+	// TasksProcessedTotal counts the tasks processed successfully by the task type
+	TasksProcessedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tasks",
+		Name:      "processed_total",
+		Help:      "Number of successfully processed asynq tasks.",
+	}, []string{"type"})
+
+	// TasksFailedTotal counts the failed attempts of processing tasks by the task type
+	TasksFailedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tasks",
+		Name:      "failed_total",
+		Help:      "Number of failed attempts to process asynq tasks.",
+	}, []string{"type"})
+)
+
+// RegisterDBStats registers the collector of the connection pool stats of the database
+func RegisterDBStats(conn *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(conn, "blog"))
+}
+
+// Handler returns the handler of the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
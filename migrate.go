@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aalug/blog-go/db/migrations"
+	"github.com/aalug/blog-go/utils"
+	zerolog "github.com/rs/zerolog/log"
+	"strconv"
+)
+
+const migrateUsage = `usage: migrate <command>
+
+commands:
+  up [N]       apply the next N migrations (all pending migrations by default)
+  down [N]     roll back the last N migrations (1 by default)
+  goto V       migrate up or down to the version V
+  status       print the current and the latest version`
+
+// runMigrateCommand runs the migrate subcommand with the embedded migrations against DB_SOURCE
+func runMigrateCommand(config utils.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, err := migrations.NewMigrator(config.DBSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "up":
+		steps, err := parseSteps(args, 0)
+		if err != nil {
+			return err
+		}
+		err = migrator.Up(steps)
+		if err != nil {
+			return fmt.Errorf("cannot migrate up: %w", err)
+		}
+	case "down":
+		steps, err := parseSteps(args, 1)
+		if err != nil {
+			return err
+		}
+		err = migrator.Down(steps)
+		if err != nil {
+			return fmt.Errorf("cannot migrate down: %w", err)
+		}
+	case "goto":
+		if len(args) != 1 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+		err = migrator.Goto(uint(version))
+		if err != nil {
+			return fmt.Errorf("cannot migrate to version %d: %w", version, err)
+		}
+	case "status":
+		if len(args) != 0 {
+			return errors.New(migrateUsage)
+		}
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return fmt.Errorf("cannot get migration status: %w", err)
+	}
+
+	fmt.Printf("version: %d, latest: %d, dirty: %t, up to date: %t\n",
+		status.Version, status.Latest, status.Dirty, status.Current())
+	return nil
+}
+
+// migrateOnStart applies all the pending migrations, when the app starts with MIGRATE_ON_START
+func migrateOnStart(config utils.Config) error {
+	migrator, err := migrations.NewMigrator(config.DBSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	err = migrator.Up(0)
+	if err != nil {
+		return err
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	zerolog.Info().Uint("version", status.Version).Msg("db migrated")
+	return nil
+}
+
+// parseSteps parses the optional number of steps of the up and down commands
+func parseSteps(args []string, defaultSteps int) (int, error) {
+	switch len(args) {
+	case 0:
+		return defaultSteps, nil
+	case 1:
+		steps, err := strconv.Atoi(args[0])
+		if err != nil || steps <= 0 {
+			return 0, fmt.Errorf("invalid number of steps %q", args[0])
+		}
+		return steps, nil
+	default:
+		return 0, errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseSteps(t *testing.T) {
+	testCases := []struct {
+		name         string
+		args         []string
+		defaultSteps int
+		steps        int
+		errMsg       string
+	}{
+		{
+			name:         "Default Steps",
+			args:         []string{},
+			defaultSteps: 1,
+			steps:        1,
+		},
+		{
+			name:  "Steps",
+			args:  []string{"3"},
+			steps: 3,
+		},
+		{
+			name:   "Zero Steps",
+			args:   []string{"0"},
+			errMsg: `invalid number of steps "0"`,
+		},
+		{
+			name:   "Negative Steps",
+			args:   []string{"-1"},
+			errMsg: `invalid number of steps "-1"`,
+		},
+		{
+			name:   "Not A Number",
+			args:   []string{"all"},
+			errMsg: `invalid number of steps "all"`,
+		},
+		{
+			name:   "Too Many Arguments",
+			args:   []string{"1", "2"},
+			errMsg: migrateUsage,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			steps, err := parseSteps(tc.args, tc.defaultSteps)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.steps, steps)
+		})
+	}
+}
+
+func TestRunMigrateCommand(t *testing.T) {
+	// the usage is returned before connecting to the db
+	err := runMigrateCommand(utils.Config{}, []string{})
+	require.EqualError(t, err, migrateUsage)
+}
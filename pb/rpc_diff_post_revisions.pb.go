@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.23.3
+// source: rpc_diff_post_revisions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DiffPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	From   int32 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     int32 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffPostRevisionsRequest) Reset() {
+	*x = DiffPostRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_diff_post_revisions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_diff_post_revisions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_diff_post_revisions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiffPostRevisionsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsRequest) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type DiffPostRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int32  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int32  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *DiffPostRevisionsResponse) Reset() {
+	*x = DiffPostRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_diff_post_revisions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_diff_post_revisions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_diff_post_revisions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DiffPostRevisionsResponse) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsResponse) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+var File_rpc_diff_post_revisions_proto protoreflect.FileDescriptor
+
+var file_rpc_diff_post_revisions_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x22, 0x57, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x19,
+	0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x42, 0x1d, 0x5a, 0x1b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x61, 0x6c, 0x75, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_diff_post_revisions_proto_rawDescOnce sync.Once
+	file_rpc_diff_post_revisions_proto_rawDescData = file_rpc_diff_post_revisions_proto_rawDesc
+)
+
+func file_rpc_diff_post_revisions_proto_rawDescGZIP() []byte {
+	file_rpc_diff_post_revisions_proto_rawDescOnce.Do(func() {
+		file_rpc_diff_post_revisions_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_diff_post_revisions_proto_rawDescData)
+	})
+	return file_rpc_diff_post_revisions_proto_rawDescData
+}
+
+var file_rpc_diff_post_revisions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_diff_post_revisions_proto_goTypes = []interface{}{
+	(*DiffPostRevisionsRequest)(nil),  // 0: pb.DiffPostRevisionsRequest
+	(*DiffPostRevisionsResponse)(nil), // 1: pb.DiffPostRevisionsResponse
+}
+var file_rpc_diff_post_revisions_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_diff_post_revisions_proto_init() }
+func file_rpc_diff_post_revisions_proto_init() {
+	if File_rpc_diff_post_revisions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_diff_post_revisions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffPostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_diff_post_revisions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffPostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_diff_post_revisions_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_diff_post_revisions_proto_goTypes,
+		DependencyIndexes: file_rpc_diff_post_revisions_proto_depIdxs,
+		MessageInfos:      file_rpc_diff_post_revisions_proto_msgTypes,
+	}.Build()
+	File_rpc_diff_post_revisions_proto = out.File
+	file_rpc_diff_post_revisions_proto_rawDesc = nil
+	file_rpc_diff_post_revisions_proto_goTypes = nil
+	file_rpc_diff_post_revisions_proto_depIdxs = nil
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.23.3
+// source: rpc_list_my_posts.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListMyPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     int32   `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status   *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+}
+
+func (x *ListMyPostsRequest) Reset() {
+	*x = ListMyPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_my_posts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyPostsRequest) ProtoMessage() {}
+
+func (x *ListMyPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_my_posts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyPostsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_my_posts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListMyPostsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyPostsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type ListMyPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*OwnPostListItem `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListMyPostsResponse) Reset() {
+	*x = ListMyPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_my_posts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyPostsResponse) ProtoMessage() {}
+
+func (x *ListMyPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_my_posts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyPostsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_my_posts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMyPostsResponse) GetPosts() []*OwnPostListItem {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+var File_rpc_list_my_posts_proto protoreflect.FileDescriptor
+
+var file_rpc_list_my_posts_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x79, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x77, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x1d, 0x5a, 0x1b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x6c, 0x75, 0x67, 0x2f, 0x62,
+	0x6c, 0x6f, 0x67, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_list_my_posts_proto_rawDescOnce sync.Once
+	file_rpc_list_my_posts_proto_rawDescData = file_rpc_list_my_posts_proto_rawDesc
+)
+
+func file_rpc_list_my_posts_proto_rawDescGZIP() []byte {
+	file_rpc_list_my_posts_proto_rawDescOnce.Do(func() {
+		file_rpc_list_my_posts_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_my_posts_proto_rawDescData)
+	})
+	return file_rpc_list_my_posts_proto_rawDescData
+}
+
+var file_rpc_list_my_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_my_posts_proto_goTypes = []interface{}{
+	(*ListMyPostsRequest)(nil),  // 0: pb.ListMyPostsRequest
+	(*ListMyPostsResponse)(nil), // 1: pb.ListMyPostsResponse
+	(*OwnPostListItem)(nil),     // 2: pb.OwnPostListItem
+}
+var file_rpc_list_my_posts_proto_depIdxs = []int32{
+	2, // 0: pb.ListMyPostsResponse.posts:type_name -> pb.OwnPostListItem
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_my_posts_proto_init() }
+func file_rpc_list_my_posts_proto_init() {
+	if File_rpc_list_my_posts_proto != nil {
+		return
+	}
+	file_post_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_my_posts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_my_posts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_list_my_posts_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_my_posts_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_my_posts_proto_goTypes,
+		DependencyIndexes: file_rpc_list_my_posts_proto_depIdxs,
+		MessageInfos:      file_rpc_list_my_posts_proto_msgTypes,
+	}.Build()
+	File_rpc_list_my_posts_proto = out.File
+	file_rpc_list_my_posts_proto_rawDesc = nil
+	file_rpc_list_my_posts_proto_goTypes = nil
+	file_rpc_list_my_posts_proto_depIdxs = nil
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.23.3
+// source: rpc_moderate_comments.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ModerateCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Status string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ModerateCommentsRequest) Reset() {
+	*x = ModerateCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_moderate_comments_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentsRequest) ProtoMessage() {}
+
+func (x *ModerateCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_moderate_comments_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_moderate_comments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModerateCommentsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ModerateCommentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ModerateCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedIds []int64 `protobuf:"varint,1,rep,packed,name=updated_ids,json=updatedIds,proto3" json:"updated_ids,omitempty"`
+}
+
+func (x *ModerateCommentsResponse) Reset() {
+	*x = ModerateCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_moderate_comments_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentsResponse) ProtoMessage() {}
+
+func (x *ModerateCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_moderate_comments_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ModerateCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_moderate_comments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModerateCommentsResponse) GetUpdatedIds() []int64 {
+	if x != nil {
+		return x.UpdatedIds
+	}
+	return nil
+}
+
+var File_rpc_moderate_comments_proto protoreflect.FileDescriptor
+
+var file_rpc_moderate_comments_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0x43, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x49, 0x64, 0x73, 0x42, 0x1d, 0x5a, 0x1b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x61, 0x6c, 0x75, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2d, 0x67, 0x6f, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_moderate_comments_proto_rawDescOnce sync.Once
+	file_rpc_moderate_comments_proto_rawDescData = file_rpc_moderate_comments_proto_rawDesc
+)
+
+func file_rpc_moderate_comments_proto_rawDescGZIP() []byte {
+	file_rpc_moderate_comments_proto_rawDescOnce.Do(func() {
+		file_rpc_moderate_comments_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_moderate_comments_proto_rawDescData)
+	})
+	return file_rpc_moderate_comments_proto_rawDescData
+}
+
+var file_rpc_moderate_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_moderate_comments_proto_goTypes = []interface{}{
+	(*ModerateCommentsRequest)(nil),  // 0: pb.ModerateCommentsRequest
+	(*ModerateCommentsResponse)(nil), // 1: pb.ModerateCommentsResponse
+}
+var file_rpc_moderate_comments_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_moderate_comments_proto_init() }
+func file_rpc_moderate_comments_proto_init() {
+	if File_rpc_moderate_comments_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_moderate_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_moderate_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_moderate_comments_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_moderate_comments_proto_goTypes,
+		DependencyIndexes: file_rpc_moderate_comments_proto_depIdxs,
+		MessageInfos:      file_rpc_moderate_comments_proto_msgTypes,
+	}.Build()
+	File_rpc_moderate_comments_proto = out.File
+	file_rpc_moderate_comments_proto_rawDesc = nil
+	file_rpc_moderate_comments_proto_goTypes = nil
+	file_rpc_moderate_comments_proto_depIdxs = nil
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// disabledLimit is the value of a route limit that disables limiting of the route
+const disabledLimit = "off"
+
+// Limit - a token bucket that holds up to Burst tokens and is refilled with Rate tokens per second
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// ParseLimit parses a limit in the "<requests>/<period>" format, for example "100/1m".
+// The bucket holds the given number of requests and is refilled in the given period.
+func ParseLimit(value string) (Limit, error) {
+	requestsValue, periodValue, ok := strings.Cut(value, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q: expected <requests>/<period>", value)
+	}
+
+	requests, err := strconv.Atoi(strings.TrimSpace(requestsValue))
+	if err != nil || requests <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q: requests must be a positive integer", value)
+	}
+
+	period, err := time.ParseDuration(strings.TrimSpace(periodValue))
+	if err != nil || period <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q: period must be a positive duration", value)
+	}
+
+	limit := Limit{
+		Rate:  float64(requests) / period.Seconds(),
+		Burst: requests,
+	}
+
+	return limit, nil
+}
+
+// Limits - the limits of the routes (Gin "<METHOD>:<path>" or gRPC full method names).
+// The routes without a limit share the default limit, if it is set.
+type Limits struct {
+	Default *Limit
+	Routes  map[string]*Limit
+}
+
+// ParseLimits parses the default limit and the route limits in the "<route>=<limit>" format.
+// The default limit can be empty and a route limit can be "off" to disable limiting.
+func ParseLimits(defaultLimit string, routeLimits []string) (Limits, error) {
+	limits := Limits{
+		Routes: make(map[string]*Limit),
+	}
+
+	if defaultLimit != "" {
+		limit, err := ParseLimit(defaultLimit)
+		if err != nil {
+			return Limits{}, err
+		}
+		limits.Default = &limit
+	}
+
+	for _, routeLimit := range routeLimits {
+		route, value, ok := strings.Cut(routeLimit, "=")
+		route = strings.TrimSpace(route)
+		if !ok || route == "" {
+			return Limits{}, fmt.Errorf("invalid route limit %q: expected <route>=<limit>", routeLimit)
+		}
+
+		if strings.TrimSpace(value) == disabledLimit {
+			limits.Routes[route] = nil
+			continue
+		}
+
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return Limits{}, err
+		}
+		limits.Routes[route] = &limit
+	}
+
+	return limits, nil
+}
+
+// bucketFor returns the limit of the route and the name of its bucket, which is shared
+// by all the routes with the default limit. Returns false if the route is not limited.
+func (limits Limits) bucketFor(route string) (Limit, string, bool) {
+	if limit, ok := limits.Routes[route]; ok {
+		if limit == nil {
+			return Limit{}, "", false
+		}
+		return *limit, route, true
+	}
+
+	if limits.Default == nil {
+		return Limit{}, "", false
+	}
+
+	return *limits.Default, "*", true
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("120/1m")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Rate: 2, Burst: 120}, limit)
+
+	invalidLimits := []string{"", "120", "0/1m", "-1/1m", "abc/1m", "120/", "120/0s", "120/abc"}
+	for _, value := range invalidLimits {
+		_, err := ParseLimit(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits("100/1m", []string{
+		"POST:/users/login=5/1m",
+		" /pb.BlogGo/LoginUser = 5/1m ",
+		"GET:/posts/all=off",
+	})
+	require.NoError(t, err)
+
+	limit, bucketName, ok := limits.bucketFor("POST:/users/login")
+	require.True(t, ok)
+	require.Equal(t, "POST:/users/login", bucketName)
+	require.Equal(t, 5, limit.Burst)
+
+	_, bucketName, ok = limits.bucketFor("/pb.BlogGo/LoginUser")
+	require.True(t, ok)
+	require.Equal(t, "/pb.BlogGo/LoginUser", bucketName)
+
+	_, _, ok = limits.bucketFor("GET:/posts/all")
+	require.False(t, ok)
+
+	// the other routes share the default bucket
+	limit, bucketName, ok = limits.bucketFor("GET:/category")
+	require.True(t, ok)
+	require.Equal(t, "*", bucketName)
+	require.Equal(t, 100, limit.Burst)
+
+	// without the default limit, the other routes are not limited
+	limits, err = ParseLimits("", nil)
+	require.NoError(t, err)
+	_, _, ok = limits.bucketFor("GET:/category")
+	require.False(t, ok)
+
+	_, err = ParseLimits("", []string{"5/1m"})
+	require.Error(t, err)
+
+	_, err = ParseLimits("", []string{"POST:/users/login=5"})
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/aalug/blog-go/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	MemoryStoreType = "memory"
+	RedisStoreType  = "redis"
+)
+
+// Limiter limits requests to the routes per subject (an authenticated user or a client IP)
+type Limiter struct {
+	store  Store
+	limits Limits
+}
+
+// NewLimiter creates a new Limiter
+func NewLimiter(store Store, limits Limits) *Limiter {
+	return &Limiter{
+		store:  store,
+		limits: limits,
+	}
+}
+
+// NewLimiterFromConfig creates a new Limiter with the limits and the store from the configuration
+func NewLimiterFromConfig(config utils.Config) (*Limiter, error) {
+	limits, err := ParseLimits(config.RateLimitDefault, config.RateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	var store Store
+	switch config.RateLimitStore {
+	case "", MemoryStoreType:
+		store = NewMemoryStore()
+	case RedisStoreType:
+		store = NewRedisStore(redis.NewClient(&redis.Options{Addr: config.RedisAddress}))
+	default:
+		return nil, fmt.Errorf("unsupported rate limit store %q", config.RateLimitStore)
+	}
+
+	return NewLimiter(store, limits), nil
+}
+
+// Allow takes a token for the request of the subject to the route.
+// Requests to the routes without a limit are always allowed.
+func (limiter *Limiter) Allow(ctx context.Context, route, subject string) (Result, error) {
+	limit, bucketName, ok := limiter.limits.bucketFor(route)
+	if !ok {
+		return Result{Allowed: true}, nil
+	}
+
+	return limiter.store.Take(ctx, bucketName+"|"+subject, limit)
+}
+
+// UserSubject returns the subject of the requests of an authenticated user
+func UserSubject(email string) string {
+	return "user:" + email
+}
+
+// IPSubject returns the subject of the requests from a client IP. The client IP must be
+// resolved with utils.ClientIP (or gin with the trusted proxies), so it cannot be spoofed.
+func IPSubject(clientIP string) string {
+	return "ip:" + utils.NormalizeIP(clientIP)
+}
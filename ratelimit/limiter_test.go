@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 10, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		result, err := store.Take(context.Background(), "key", limit)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+
+	result, err := store.Take(context.Background(), "key", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.InDelta(t, 100*time.Millisecond, result.RetryAfter, float64(10*time.Millisecond))
+	require.Equal(t, 1, result.RetryAfterSeconds())
+
+	// other keys have their own buckets
+	result, err = store.Take(context.Background(), "other", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	// the bucket is refilled with the rate
+	time.Sleep(120 * time.Millisecond)
+	result, err = store.Take(context.Background(), "key", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+}
+
+func TestMemoryStore_removeLeastRecentlyUsed(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 0.001, Burst: 2}
+
+	// the busy key has used its tokens
+	for i := 0; i < 2; i++ {
+		result, err := store.Take(context.Background(), "busy", limit)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+
+	// many new keys, e.g. from different IPs, keep the busy key in use
+	for i := 0; i < 3*maxMemoryBuckets; i++ {
+		_, err := store.Take(context.Background(), fmt.Sprintf("key-%d", i), limit)
+		require.NoError(t, err)
+
+		if i%100 == 0 {
+			result, err := store.Take(context.Background(), "busy", limit)
+			require.NoError(t, err)
+			require.False(t, result.Allowed)
+		}
+	}
+	require.Len(t, store.buckets, maxMemoryBuckets)
+	require.Equal(t, maxMemoryBuckets, store.recent.Len())
+
+	// the busy key is still limited, the least recently used keys are removed
+	result, err := store.Take(context.Background(), "busy", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.NotContains(t, store.buckets, "key-0")
+	require.Contains(t, store.buckets, fmt.Sprintf("key-%d", 3*maxMemoryBuckets-1))
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	limits, err := ParseLimits("2/1m", []string{"/pb.BlogGo/LoginUser=1/1m", "/pb.BlogGo/ListPosts=off"})
+	require.NoError(t, err)
+	limiter := NewLimiter(NewMemoryStore(), limits)
+
+	allow := func(route, subject string) bool {
+		result, err := limiter.Allow(context.Background(), route, subject)
+		require.NoError(t, err)
+		return result.Allowed
+	}
+
+	subject := IPSubject("192.0.2.1:1234")
+	require.Equal(t, "ip:192.0.2.1", subject)
+
+	// the routes with their own limit have their own buckets
+	require.True(t, allow("/pb.BlogGo/LoginUser", subject))
+	require.False(t, allow("/pb.BlogGo/LoginUser", subject))
+
+	// the other routes share the default bucket
+	require.True(t, allow("/pb.BlogGo/GetPost", subject))
+	require.True(t, allow("/pb.BlogGo/ListTags", subject))
+	require.False(t, allow("/pb.BlogGo/GetPost", subject))
+
+	// routes with the limit disabled are not limited
+	for i := 0; i < 5; i++ {
+		require.True(t, allow("/pb.BlogGo/ListPosts", subject))
+	}
+
+	// subjects have their own buckets
+	require.True(t, allow("/pb.BlogGo/LoginUser", UserSubject("user@example.com")))
+}
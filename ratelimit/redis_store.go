@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const redisKeyPrefix = "ratelimit:"
+
+// takeScript refills and takes a token from the bucket atomically, using the clock of Redis.
+// Buckets expire after they would be refilled. Numbers are returned as strings,
+// because Redis truncates Lua numbers to integers.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1])
+local updated_at = tonumber(bucket[2])
+if tokens == nil or updated_at == nil then
+	tokens = burst
+	updated_at = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated_at) * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = (1 - tokens) / rate
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000))
+
+return {allowed, tostring(retry_after)}
+`)
+
+// RedisStore keeps the token buckets in Redis, so they are shared between processes
+type RedisStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisStore creates a new RedisStore
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{
+		client: client,
+	}
+}
+
+// Take takes a token from the bucket of the key with the limit
+func (store *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	values, err := takeScript.Run(ctx, store.client, []string{redisKeyPrefix + key}, limit.Rate, limit.Burst).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take token: %w", err)
+	}
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("failed to take token: unexpected result %v", values)
+	}
+
+	allowed, _ := values[0].(int64)
+	retryAfterValue, _ := values[1].(string)
+	retryAfter, err := strconv.ParseFloat(retryAfterValue, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take token: %w", err)
+	}
+
+	result := Result{
+		Allowed:    allowed == 1,
+		RetryAfter: time.Duration(retryAfter * float64(time.Second)),
+	}
+
+	return result, nil
+}
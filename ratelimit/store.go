@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// maxMemoryBuckets is the number of buckets after which the least recently used ones are removed
+const maxMemoryBuckets = 10000
+
+// Result - the result of taking a token from a bucket
+type Result struct {
+	Allowed bool
+	// RetryAfter is the time until a token is available, if the request is not allowed
+	RetryAfter time.Duration
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header of the result
+func (result Result) RetryAfterSeconds() int {
+	return int(math.Ceil(result.RetryAfter.Seconds()))
+}
+
+// Store - storage of the token buckets
+type Store interface {
+	// Take takes a token from the bucket of the key with the limit
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+type bucket struct {
+	key       string
+	tokens    float64
+	updatedAt time.Time
+	limit     Limit
+}
+
+// refill adds the tokens refilled since the last update
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.updatedAt = now
+	}
+}
+
+// MemoryStore keeps the token buckets in memory, so they are not shared between processes.
+// It keeps up to maxMemoryBuckets buckets and removes the least recently used ones,
+// so the buckets of the busy keys are kept even when many new keys are used.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*list.Element
+	// recent holds the buckets from the most to the least recently used
+	recent *list.List
+}
+
+// NewMemoryStore creates a new MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*list.Element),
+		recent:  list.New(),
+	}
+}
+
+// Take takes a token from the bucket of the key with the limit
+func (store *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var b *bucket
+	if element, ok := store.buckets[key]; ok {
+		store.recent.MoveToFront(element)
+		b = element.Value.(*bucket)
+	} else {
+		if len(store.buckets) >= maxMemoryBuckets {
+			store.removeLeastRecentlyUsed()
+		}
+		b = &bucket{key: key, tokens: float64(limit.Burst), updatedAt: now}
+		store.buckets[key] = store.recent.PushFront(b)
+	}
+	b.limit = limit
+	b.refill(now)
+
+	if b.tokens < 1 {
+		retryAfter := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+		return Result{Allowed: false, RetryAfter: retryAfter}, nil
+	}
+
+	b.tokens--
+	return Result{Allowed: true}, nil
+}
+
+// removeLeastRecentlyUsed removes the bucket that has not been used for the longest time.
+// The caller must hold the lock.
+func (store *MemoryStore) removeLeastRecentlyUsed() {
+	element := store.recent.Back()
+	if element == nil {
+		return
+	}
+
+	store.recent.Remove(element)
+	delete(store.buckets, element.Value.(*bucket).key)
+}
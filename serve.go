@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/metrics"
+	"github.com/aalug/blog-go/tracing"
+	"github.com/aalug/blog-go/utils"
+	"github.com/aalug/blog-go/worker"
+	"github.com/hibiken/asynq"
+	zerolog "github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"net/http"
+	"os/signal"
+)
+
+const serveUsage = `usage: serve [-grpc] [-gateway] [-gin] [-worker] [-scheduler]
+
+  start the selected components, or the gRPC server, the HTTP gateway,
+  the worker and the scheduler if none is selected
+
+flags:
+  -grpc        the gRPC server at GRPC_SERVER_ADDRESS
+  -gateway     the HTTP gateway at GATEWAY_SERVER_ADDRESS, forwarding to GATEWAY_GRPC_ENDPOINT
+  -gin         the Gin HTTP server at HTTP_SERVER_ADDRESS
+  -worker      the task processor and the outbox relay
+  -scheduler   the scheduler of the periodic tasks, run it in one process only`
+
+// serveComponents - the components started by the serve command
+type serveComponents struct {
+	grpc      bool
+	gateway   bool
+	gin       bool
+	worker    bool
+	scheduler bool
+}
+
+// parseServeFlags parses the flags of the serve command
+func parseServeFlags(args []string) (serveComponents, error) {
+	var components serveComponents
+
+	flags := newFlagSet("serve")
+	flags.BoolVar(&components.grpc, "grpc", false, "start the gRPC server")
+	flags.BoolVar(&components.gateway, "gateway", false, "start the HTTP gateway")
+	flags.BoolVar(&components.gin, "gin", false, "start the Gin HTTP server")
+	flags.BoolVar(&components.worker, "worker", false, "start the task processor and the outbox relay")
+	flags.BoolVar(&components.scheduler, "scheduler", false, "start the task scheduler")
+	err := parseFlags(flags, serveUsage, args)
+	if err != nil {
+		return components, err
+	}
+
+	if components == (serveComponents{}) {
+		components = serveComponents{grpc: true, gateway: true, worker: true, scheduler: true}
+	}
+
+	return components, nil
+}
+
+// runServeCommand starts the selected components and stops them gracefully on a signal
+func runServeCommand(config utils.Config, args []string) error {
+	components, err := parseServeFlags(args)
+	if err != nil {
+		return err
+	}
+
+	if components.gateway && components.gin && gatewayServerAddress(config) == config.HTTPServerAddress {
+		return errors.New("-gateway and -gin cannot listen at the same address, set GATEWAY_SERVER_ADDRESS")
+	}
+
+	if config.MigrateOnStart {
+		err = migrateOnStart(config)
+		if err != nil {
+			return fmt.Errorf("cannot migrate the db: %w", err)
+		}
+	}
+
+	// === tracing ===
+	shutdownTracing, err := tracing.Setup(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("cannot set up tracing: %w", err)
+	}
+
+	// === postgres ===
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		return fmt.Errorf("cannot connect to the db: %w", err)
+	}
+
+	metrics.RegisterDBStats(conn)
+
+	store := db.NewStore(conn)
+
+	// === redis ===
+	redisOpt := asynq.RedisClientOpt{
+		Addr: config.RedisAddress,
+	}
+
+	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
+
+	// === health ===
+	healthChecker := newHealthChecker(config, conn)
+
+	// === lifecycle ===
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	var taskProcessor worker.TaskProcessor
+	var outboxRelay worker.OutboxRelay
+	if components.worker {
+		taskProcessor = runTaskProcessor(waitGroup, config, redisOpt, store)
+		outboxRelay = runOutboxRelay(waitGroup, config, redisOpt, store)
+	}
+
+	var taskScheduler worker.TaskScheduler
+	if components.scheduler {
+		taskScheduler = runTaskScheduler(waitGroup, redisOpt)
+	}
+
+	// the connection of the gateway to the gRPC server is closed only after the gateway
+	// finishes the in-flight requests, so it is not bound to ctx
+	gatewayCtx, closeGateway := context.WithCancel(context.Background())
+	defer closeGateway()
+
+	var httpServers []*http.Server
+	if components.gateway {
+		httpServers = append(httpServers, runGatewayServer(gatewayCtx, waitGroup, config, store, taskDistributor, healthChecker))
+	}
+	if components.gin {
+		httpServers = append(httpServers, runGinServer(waitGroup, config, store, taskDistributor, healthChecker))
+	}
+
+	var grpcServer *grpc.Server
+	if components.grpc {
+		grpcServer = runGrpcServer(ctx, waitGroup, config, store, taskDistributor, healthChecker)
+	}
+
+	var opsServer *http.Server
+	if config.OpsServerAddress != "" {
+		opsServer = runOpsServer(waitGroup, config, healthChecker)
+	}
+
+	// on a signal, or when any component fails, the components are stopped in order:
+	// the HTTP servers and then the gRPC server finish the in-flight requests first,
+	// then the worker finishes the tasks in progress. The ops server stops last.
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		zerolog.Info().Msg("graceful shutdown started")
+		healthChecker.Shutdown()
+
+		shutdownTimeout := config.ShutdownTimeout
+		if shutdownTimeout <= 0 {
+			shutdownTimeout = defaultShutdownTimeout
+		}
+
+		for _, httpServer := range httpServers {
+			stopHTTPServer(httpServer, shutdownTimeout)
+		}
+		closeGateway()
+
+		if grpcServer != nil {
+			stopGrpcServer(grpcServer, shutdownTimeout)
+		}
+
+		if taskScheduler != nil {
+			taskScheduler.Shutdown()
+		}
+		if outboxRelay != nil {
+			outboxRelay.Shutdown()
+			zerolog.Info().Msg("outbox relay stopped")
+		}
+		if taskProcessor != nil {
+			taskProcessor.Shutdown()
+			zerolog.Info().Msg("task processor stopped")
+		}
+
+		if opsServer != nil {
+			stopHTTPServer(opsServer, shutdownTimeout)
+		}
+
+		return nil
+	})
+
+	err = waitGroup.Wait()
+
+	// the DB pool is closed once nothing uses it anymore
+	if closeErr := conn.Close(); closeErr != nil {
+		zerolog.Error().Err(closeErr).Msg("cannot close the db connection pool")
+	}
+
+	tracingCtx, cancel := context.WithTimeout(context.Background(), defaultShutdownTimeout)
+	defer cancel()
+	if shutdownErr := shutdownTracing(tracingCtx); shutdownErr != nil {
+		zerolog.Error().Err(shutdownErr).Msg("cannot flush the traces")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error from the wait group: %w", err)
+	}
+	zerolog.Info().Msg("graceful shutdown completed")
+	return nil
+}
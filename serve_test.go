@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseServeFlags(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		components serveComponents
+		errMsg     string
+	}{
+		{
+			name:       "Default Components",
+			args:       []string{},
+			components: serveComponents{grpc: true, gateway: true, worker: true, scheduler: true},
+		},
+		{
+			name:       "Selected Components",
+			args:       []string{"-gin", "-worker"},
+			components: serveComponents{gin: true, worker: true},
+		},
+		{
+			name:       "All Components",
+			args:       []string{"-grpc", "-gateway", "-gin", "-worker", "-scheduler"},
+			components: serveComponents{grpc: true, gateway: true, gin: true, worker: true, scheduler: true},
+		},
+		{
+			name:   "Unknown Flag",
+			args:   []string{"-http"},
+			errMsg: "serve: flag provided but not defined: -http\n\n" + serveUsage,
+		},
+		{
+			name:   "Unexpected Arguments",
+			args:   []string{"-grpc", "worker"},
+			errMsg: "serve: unexpected arguments [worker]\n\n" + serveUsage,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			components, err := parseServeFlags(tc.args)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.components, components)
+		})
+	}
+}
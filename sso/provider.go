@@ -0,0 +1,194 @@
+package sso
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/aalug/blog-go/utils"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrNotConfigured   = errors.New("OIDC login is not configured")
+	ErrInvalidIdentity = errors.New("invalid identity from the OIDC provider")
+)
+
+// usernameBaseLength is the max length of the part of a generated username taken from the identity
+const usernameBaseLength = 20
+
+var notAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// Identity - a user identity verified by the OIDC provider
+type Identity struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// AuthRequest - the values that bind the authorization response to the request that started the login.
+// State and CodeVerifier must be kept by the server until the callback, the Nonce is sent to the provider.
+type AuthRequest struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// Provider - an OpenID Connect provider used for social login (authorization code flow with PKCE)
+type Provider struct {
+	oauth2Config oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+}
+
+// NewProvider creates a new Provider from the configuration. The endpoints are discovered
+// from the issuer, unless all of them are set explicitly (e.g. for a local stand-in provider).
+// Returns ErrNotConfigured if the issuer is not set.
+func NewProvider(ctx context.Context, config utils.Config) (*Provider, error) {
+	if config.OIDCIssuerURL == "" {
+		return nil, ErrNotConfigured
+	}
+
+	var provider *oidc.Provider
+	if config.OIDCAuthURL != "" && config.OIDCTokenURL != "" && config.OIDCJWKSURL != "" {
+		providerConfig := oidc.ProviderConfig{
+			IssuerURL: config.OIDCIssuerURL,
+			AuthURL:   config.OIDCAuthURL,
+			TokenURL:  config.OIDCTokenURL,
+			JWKSURL:   config.OIDCJWKSURL,
+		}
+		provider = providerConfig.NewProvider(ctx)
+	} else {
+		var err error
+		provider, err = oidc.NewProvider(ctx, config.OIDCIssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("cannot discover OIDC provider: %w", err)
+		}
+	}
+
+	p := &Provider{
+		oauth2Config: oauth2.Config{
+			ClientID:     config.OIDCClientID,
+			ClientSecret: config.OIDCClientSecret,
+			RedirectURL:  config.OIDCRedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: config.OIDCClientID}),
+	}
+
+	return p, nil
+}
+
+// NewAuthRequest generates random state, nonce and PKCE code verifier of a new login
+func NewAuthRequest() (AuthRequest, error) {
+	values := make([]string, 3)
+	for i := range values {
+		value, err := utils.RandomToken()
+		if err != nil {
+			return AuthRequest{}, err
+		}
+		values[i] = value
+	}
+
+	request := AuthRequest{
+		State:        values[0],
+		Nonce:        values[1],
+		CodeVerifier: values[2],
+	}
+
+	return request, nil
+}
+
+// AuthCodeURL returns the URL of the provider's consent page for the login request
+func (provider *Provider) AuthCodeURL(request AuthRequest) string {
+	return provider.oauth2Config.AuthCodeURL(
+		request.State,
+		oidc.Nonce(request.Nonce),
+		oauth2.SetAuthURLParam("code_challenge", codeChallenge(request.CodeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+}
+
+// Exchange exchanges the authorization code for the tokens, verifies the ID token
+// (signature, issuer, audience, expiration and nonce) and returns the identity from it
+func (provider *Provider) Exchange(ctx context.Context, code string, request AuthRequest) (Identity, error) {
+	oauth2Token, err := provider.oauth2Config.Exchange(
+		ctx,
+		code,
+		oauth2.SetAuthURLParam("code_verifier", request.CodeVerifier),
+	)
+	if err != nil {
+		return Identity{}, fmt.Errorf("cannot exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return Identity{}, fmt.Errorf("%w: missing id_token", ErrInvalidIdentity)
+	}
+
+	idToken, err := provider.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %s", ErrInvalidIdentity, err)
+	}
+
+	if idToken.Nonce != request.Nonce {
+		return Identity{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIdentity)
+	}
+
+	var claims struct {
+		Email             string `json:"email"`
+		EmailVerified     bool   `json:"email_verified"`
+		Name              string `json:"name"`
+		PreferredUsername string `json:"preferred_username"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return Identity{}, fmt.Errorf("%w: %s", ErrInvalidIdentity, err)
+	}
+
+	identity := Identity{
+		Issuer:            idToken.Issuer,
+		Subject:           idToken.Subject,
+		Email:             strings.ToLower(claims.Email),
+		EmailVerified:     claims.EmailVerified,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+	}
+
+	return identity, nil
+}
+
+// Username returns a username for a user created from the identity.
+// It is based on the preferred username, the name or the email and has a random suffix,
+// so it is alphanumeric and does not collide with existing usernames.
+func (identity Identity) Username() string {
+	base := identity.PreferredUsername
+	if base == "" {
+		base = identity.Name
+	}
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	base = notAlphanumeric.ReplaceAllString(base, "")
+	if len(base) > usernameBaseLength {
+		base = base[:usernameBaseLength]
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	return base + utils.RandomString(6)
+}
+
+// codeChallenge returns the S256 PKCE code challenge of the code verifier
+func codeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
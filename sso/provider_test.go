@@ -0,0 +1,146 @@
+package sso
+
+import (
+	"context"
+	"github.com/aalug/blog-go/sso/ssotest"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestProvider(t *testing.T) {
+	oidcServer := ssotest.NewServer(t)
+
+	provider, err := NewProvider(context.Background(), oidcServer.Config(utils.Config{}))
+	require.NoError(t, err)
+
+	request, err := NewAuthRequest()
+	require.NoError(t, err)
+
+	authCodeURL := provider.AuthCodeURL(request)
+	u, err := url.Parse(authCodeURL)
+	require.NoError(t, err)
+	require.Equal(t, request.Nonce, u.Query().Get("nonce"))
+	require.Equal(t, codeChallenge(request.CodeVerifier), u.Query().Get("code_challenge"))
+
+	claims := ssotest.Claims{
+		Subject:       utils.RandomString(10),
+		Email:         utils.RandomEmail(),
+		EmailVerified: true,
+		Name:          utils.RandomString(8),
+	}
+	code, state := oidcServer.Authorize(t, authCodeURL, claims)
+	require.Equal(t, request.State, state)
+
+	identity, err := provider.Exchange(context.Background(), code, request)
+	require.NoError(t, err)
+	require.Equal(t, oidcServer.URL, identity.Issuer)
+	require.Equal(t, claims.Subject, identity.Subject)
+	require.Equal(t, claims.Email, identity.Email)
+	require.True(t, identity.EmailVerified)
+	require.Equal(t, claims.Name, identity.Name)
+
+	// the code can be used only once
+	_, err = provider.Exchange(context.Background(), code, request)
+	require.Error(t, err)
+}
+
+func TestProviderDiscovery(t *testing.T) {
+	oidcServer := ssotest.NewServer(t)
+
+	config := oidcServer.Config(utils.Config{})
+	config.OIDCAuthURL = ""
+	config.OIDCTokenURL = ""
+	config.OIDCJWKSURL = ""
+
+	provider, err := NewProvider(context.Background(), config)
+	require.NoError(t, err)
+	require.Equal(t, oidcServer.URL+"/token", provider.oauth2Config.Endpoint.TokenURL)
+}
+
+func TestProviderInvalidExchange(t *testing.T) {
+	oidcServer := ssotest.NewServer(t)
+
+	provider, err := NewProvider(context.Background(), oidcServer.Config(utils.Config{}))
+	require.NoError(t, err)
+
+	claims := ssotest.Claims{Subject: utils.RandomString(10), Email: utils.RandomEmail()}
+
+	testCases := []struct {
+		name   string
+		modify func(request *AuthRequest)
+	}{
+		{
+			name: "Wrong Code Verifier",
+			modify: func(request *AuthRequest) {
+				request.CodeVerifier = utils.RandomString(43)
+			},
+		},
+		{
+			name: "Wrong Nonce",
+			modify: func(request *AuthRequest) {
+				request.Nonce = utils.RandomString(43)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := NewAuthRequest()
+			require.NoError(t, err)
+
+			code, _ := oidcServer.Authorize(t, provider.AuthCodeURL(request), claims)
+
+			tc.modify(&request)
+			_, err = provider.Exchange(context.Background(), code, request)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewProviderNotConfigured(t *testing.T) {
+	_, err := NewProvider(context.Background(), utils.Config{})
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
+
+func TestIdentity_Username(t *testing.T) {
+	testCases := []struct {
+		name     string
+		identity Identity
+		prefix   string
+	}{
+		{
+			name:     "Preferred Username",
+			identity: Identity{PreferredUsername: "john.doe", Name: "John Doe", Email: "jd@example.com"},
+			prefix:   "johndoe",
+		},
+		{
+			name:     "Name",
+			identity: Identity{Name: "John Doe", Email: "jd@example.com"},
+			prefix:   "JohnDoe",
+		},
+		{
+			name:     "Email",
+			identity: Identity{Email: "jd@example.com"},
+			prefix:   "jd",
+		},
+		{
+			name:     "No Alphanumeric Characters",
+			identity: Identity{Name: "..."},
+			prefix:   "user",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			username := tc.identity.Username()
+			require.Regexp(t, regexp.MustCompile("^"+tc.prefix+"[a-z]{6}$"), username)
+		})
+	}
+}
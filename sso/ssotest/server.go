@@ -0,0 +1,196 @@
+// Package ssotest provides a local stand-in OIDC provider for tests.
+package ssotest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aalug/blog-go/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+const keyID = "ssotest"
+
+// Claims - the claims of the ID token issued for an authorization
+type Claims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type authorization struct {
+	claims        Claims
+	nonce         string
+	codeChallenge string
+}
+
+// Server - a stand-in OIDC provider. It skips the consent page: tests authorize
+// a login request directly with Authorize and pass the returned code to the callback.
+// ID tokens are signed with RS256 and the keys are published at /jwks.
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	key            *rsa.PrivateKey
+	mu             sync.Mutex
+	authorizations map[string]authorization
+}
+
+// NewServer starts a new stand-in OIDC provider, which is closed when the test finishes
+func NewServer(t *testing.T) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := &Server{
+		ClientID:       utils.RandomString(12),
+		ClientSecret:   utils.RandomString(32),
+		key:            key,
+		authorizations: make(map[string]authorization),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", server.handleDiscovery)
+	mux.HandleFunc("/token", server.handleToken)
+	mux.HandleFunc("/jwks", server.handleJWKS)
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// Config returns the configuration with the OIDC settings pointing to the server
+func (server *Server) Config(config utils.Config) utils.Config {
+	config.OIDCIssuerURL = server.URL
+	config.OIDCAuthURL = server.URL + "/authorize"
+	config.OIDCTokenURL = server.URL + "/token"
+	config.OIDCJWKSURL = server.URL + "/jwks"
+	config.OIDCClientID = server.ClientID
+	config.OIDCClientSecret = server.ClientSecret
+	config.OIDCRedirectURL = "http://localhost/oidc/callback"
+	return config
+}
+
+// Authorize authorizes the login request of the auth code URL as the user with the claims,
+// as if the user consented on the provider's page. Returns the code and the state from the redirect.
+func (server *Server) Authorize(t *testing.T, authCodeURL string, claims Claims) (string, string) {
+	u, err := url.Parse(authCodeURL)
+	require.NoError(t, err)
+
+	query := u.Query()
+	require.Equal(t, server.ClientID, query.Get("client_id"))
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+
+	code := utils.RandomString(32)
+
+	server.mu.Lock()
+	server.authorizations[code] = authorization{
+		claims:        claims,
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+	}
+	server.mu.Unlock()
+
+	return code, query.Get("state")
+}
+
+func (server *Server) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                server.URL,
+		"authorization_endpoint":                server.URL + "/authorize",
+		"token_endpoint":                        server.URL + "/token",
+		"jwks_uri":                              server.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (server *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != server.ClientID || clientSecret != server.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	// codes can be used only once
+	code := r.PostForm.Get("code")
+	server.mu.Lock()
+	auth, ok := server.authorizations[code]
+	delete(server.authorizations, code)
+	server.mu.Unlock()
+
+	verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                server.URL,
+		"sub":                auth.claims.Subject,
+		"aud":                server.ClientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Minute).Unix(),
+		"nonce":              auth.nonce,
+		"email":              auth.claims.Email,
+		"email_verified":     auth.claims.EmailVerified,
+		"name":               auth.claims.Name,
+		"preferred_username": auth.claims.PreferredUsername,
+	})
+	idToken.Header["kid"] = keyID
+
+	signedIDToken, err := idToken.SignedString(server.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": utils.RandomString(32),
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     signedIDToken,
+	})
+}
+
+func (server *Server) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	publicKey := server.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
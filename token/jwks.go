@@ -0,0 +1,22 @@
+package token
+
+// JWK - a public key in the JSON Web Key format
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKS - a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeySetPublisher is implemented by makers that use asymmetric keys,
+// so other services can verify the tokens with the published public keys
+type KeySetPublisher interface {
+	JWKS() JWKS
+}
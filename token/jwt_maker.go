@@ -0,0 +1,170 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JWTMaker - a JWT token maker that signs tokens with Ed25519 keys (EdDSA).
+// Tokens are signed with the signing key and verified with any of the keys,
+// which are identified by the kid header, so keys can be rotated without
+// invalidating tokens signed with the previous ones.
+type JWTMaker struct {
+	keys         map[string]ed25519.PrivateKey
+	signingKeyID string
+}
+
+type jwtClaims struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func init() {
+	// the times are encoded with a nanosecond instead of a second precision and rounded to microseconds
+	// when decoded, so the issue time keeps the microsecond precision of the payload
+	jwt.TimePrecision = time.Nanosecond
+}
+
+// NewJWTMaker creates a new JWTMaker. keys maps key ids to the private keys,
+// signingKeyID is the id of the key used to sign new tokens.
+func NewJWTMaker(keys map[string]ed25519.PrivateKey, signingKeyID string) (Maker, error) {
+	if _, ok := keys[signingKeyID]; !ok {
+		return nil, fmt.Errorf("signing key %q not found", signingKeyID)
+	}
+
+	for kid, key := range keys {
+		if len(key) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("invalid size of key %q", kid)
+		}
+	}
+
+	maker := &JWTMaker{
+		keys:         keys,
+		signingKeyID: signingKeyID,
+	}
+
+	return maker, nil
+}
+
+// ParseJWTKeys parses keys in the "kid:seed" format,
+// where seed is a base64 (URL-safe, unpadded) encoded 32-byte Ed25519 seed
+func ParseJWTKeys(values []string) (map[string]ed25519.PrivateKey, error) {
+	keys := make(map[string]ed25519.PrivateKey, len(values))
+	for _, value := range values {
+		kid, encodedSeed, found := strings.Cut(strings.TrimSpace(value), ":")
+		if !found || kid == "" {
+			return nil, fmt.Errorf("invalid key format, must be kid:seed")
+		}
+
+		if _, ok := keys[kid]; ok {
+			return nil, fmt.Errorf("duplicated key %q", kid)
+		}
+
+		seed, err := base64.RawURLEncoding.DecodeString(encodedSeed)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid seed of key %q, must be %d base64 encoded bytes", kid, ed25519.SeedSize)
+		}
+
+		keys[kid] = ed25519.NewKeyFromSeed(seed)
+	}
+
+	return keys, nil
+}
+
+// CreateToken creates a new token for a specific email, role and duration
+func (maker *JWTMaker) CreateToken(email string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	claims := jwtClaims{
+		Email: payload.Email,
+		Role:  payload.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
+			Subject:   payload.Email,
+			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+		},
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	jwtToken.Header["kid"] = maker.signingKeyID
+
+	token, err := jwtToken.SignedString(maker.keys[maker.signingKeyID])
+	return token, payload, err
+}
+
+// VerifyToken checks if the token is valid
+func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
+	claims := &jwtClaims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, maker.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil || claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{
+		ID:        tokenID,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		IssuedAt:  claims.IssuedAt.Time.Round(time.Microsecond),
+		ExpiredAt: claims.ExpiresAt.Time.Round(time.Microsecond),
+	}
+
+	return payload, nil
+}
+
+// JWKS returns the public keys of the maker as a JSON Web Key Set
+func (maker *JWTMaker) JWKS() JWKS {
+	jwks := JWKS{Keys: make([]JWK, 0, len(maker.keys))}
+	for kid, key := range maker.keys {
+		jwks.Keys = append(jwks.Keys, JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key.Public().(ed25519.PublicKey)),
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+		})
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+
+	return jwks
+}
+
+// verificationKey returns the public key of the kid from the token header
+func (maker *JWTMaker) verificationKey(jwtToken *jwt.Token) (interface{}, error) {
+	kid, ok := jwtToken.Header["kid"].(string)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	key, ok := maker.keys[kid]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return key.Public(), nil
+}
@@ -0,0 +1,211 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"github.com/aalug/blog-go/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// randomJWTKey returns a random key in the "kid:seed" format
+func randomJWTKey(t *testing.T, kid string) string {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	return fmt.Sprintf("%s:%s", kid, base64.RawURLEncoding.EncodeToString(privateKey.Seed()))
+}
+
+// newTestJWTMaker creates a JWTMaker from keys in the "kid:seed" format
+func newTestJWTMaker(t *testing.T, signingKeyID string, keys ...string) Maker {
+	parsedKeys, err := ParseJWTKeys(keys)
+	require.NoError(t, err)
+
+	maker, err := NewJWTMaker(parsedKeys, signingKeyID)
+	require.NoError(t, err)
+
+	return maker
+}
+
+func TestJWTMaker(t *testing.T) {
+	maker := newTestJWTMaker(t, "key1", randomJWTKey(t, "key1"))
+
+	email := utils.RandomEmail()
+	role := utils.AuthorRole
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(email, role, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	verifiedPayload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, verifiedPayload)
+
+	require.Equal(t, payload.ID, verifiedPayload.ID)
+	require.Equal(t, email, verifiedPayload.Email)
+	require.Equal(t, role, verifiedPayload.Role)
+	require.WithinDuration(t, issuedAt, verifiedPayload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, verifiedPayload.ExpiredAt, time.Second)
+	// the issue time keeps its microsecond precision
+	require.True(t, payload.IssuedAt.Equal(verifiedPayload.IssuedAt))
+}
+
+func TestExpiredJWTToken(t *testing.T) {
+	maker := newTestJWTMaker(t, "key1", randomJWTKey(t, "key1"))
+
+	// negative duration -> always expired
+	token, payload, err := maker.CreateToken(utils.RandomEmail(), utils.AuthorRole, -time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestJWTMakerKeyRotation(t *testing.T) {
+	oldKey := randomJWTKey(t, "old")
+	newKey := randomJWTKey(t, "new")
+
+	oldMaker := newTestJWTMaker(t, "old", oldKey)
+	oldToken, _, err := oldMaker.CreateToken(utils.RandomEmail(), utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+
+	// the new key signs new tokens, the old one still verifies the tokens signed with it
+	rotatedMaker := newTestJWTMaker(t, "new", oldKey, newKey)
+	_, err = rotatedMaker.VerifyToken(oldToken)
+	require.NoError(t, err)
+
+	newToken, _, err := rotatedMaker.CreateToken(utils.RandomEmail(), utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+	_, err = rotatedMaker.VerifyToken(newToken)
+	require.NoError(t, err)
+
+	// once the old key is removed, its tokens are no longer valid
+	newMaker := newTestJWTMaker(t, "new", newKey)
+	_, err = newMaker.VerifyToken(oldToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	_, err = newMaker.VerifyToken(newToken)
+	require.NoError(t, err)
+}
+
+func TestInvalidJWTToken(t *testing.T) {
+	maker := newTestJWTMaker(t, "key1", randomJWTKey(t, "key1"))
+
+	payload, err := NewPayload(utils.RandomEmail(), utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+
+	claims := jwtClaims{
+		Email: payload.Email,
+		Role:  payload.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+		},
+	}
+
+	// unsigned token
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+	jwtToken.Header["kid"] = "key1"
+	token, err := jwtToken.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+
+	// token signed with an unknown key with the same kid
+	otherMaker := newTestJWTMaker(t, "key1", randomJWTKey(t, "key1"))
+	token, _, err = otherMaker.CreateToken(payload.Email, payload.Role, time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+}
+
+func TestJWTMaker_JWKS(t *testing.T) {
+	keys, err := ParseJWTKeys([]string{randomJWTKey(t, "b"), randomJWTKey(t, "a")})
+	require.NoError(t, err)
+
+	maker, err := NewJWTMaker(keys, "b")
+	require.NoError(t, err)
+
+	publisher, ok := maker.(KeySetPublisher)
+	require.True(t, ok)
+
+	jwks := publisher.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, "a", jwks.Keys[0].Kid)
+	require.Equal(t, "b", jwks.Keys[1].Kid)
+
+	for _, key := range jwks.Keys {
+		require.Equal(t, "OKP", key.Kty)
+		require.Equal(t, "Ed25519", key.Crv)
+		require.Equal(t, "EdDSA", key.Alg)
+
+		publicKey, err := base64.RawURLEncoding.DecodeString(key.X)
+		require.NoError(t, err)
+		require.Equal(t, keys[key.Kid].Public(), ed25519.PublicKey(publicKey))
+	}
+}
+
+func TestParseJWTKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		keys []string
+	}{
+		{
+			name: "Missing Kid",
+			keys: []string{base64.RawURLEncoding.EncodeToString(make([]byte, ed25519.SeedSize))},
+		},
+		{
+			name: "Invalid Seed",
+			keys: []string{"key1:invalid"},
+		},
+		{
+			name: "Duplicated Kid",
+			keys: []string{randomJWTKey(t, "key1"), randomJWTKey(t, "key1")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseJWTKeys(tc.keys)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewMaker(t *testing.T) {
+	maker, err := NewMaker(utils.Config{TokenSymmetricKey: utils.RandomString(32)})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	maker, err = NewMaker(utils.Config{
+		TokenMaker:           JWTMakerType,
+		TokenJWTKeys:         []string{randomJWTKey(t, "key1")},
+		TokenJWTSigningKeyID: "key1",
+	})
+	require.NoError(t, err)
+	require.IsType(t, &JWTMaker{}, maker)
+
+	_, err = NewMaker(utils.Config{
+		TokenMaker:           JWTMakerType,
+		TokenJWTKeys:         []string{randomJWTKey(t, "key1")},
+		TokenJWTSigningKeyID: "key2",
+	})
+	require.Error(t, err)
+
+	_, err = NewMaker(utils.Config{TokenMaker: "unknown"})
+	require.Error(t, err)
+}
@@ -4,6 +4,6 @@ import "time"
 
 // Maker - interface for managing tokens
 type Maker interface {
-	CreateToken(email string, duration time.Duration) (string, *Payload, error)
+	CreateToken(email string, role string, duration time.Duration) (string, *Payload, error)
 	VerifyToken(token string) (*Payload, error)
 }
@@ -0,0 +1,28 @@
+package token
+
+import (
+	"fmt"
+	"github.com/aalug/blog-go/utils"
+)
+
+const (
+	PasetoMakerType = "paseto"
+	JWTMakerType    = "jwt"
+)
+
+// NewMaker creates the token maker chosen in the configuration.
+// PASETO is used when no maker is configured.
+func NewMaker(config utils.Config) (Maker, error) {
+	switch config.TokenMaker {
+	case "", PasetoMakerType:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	case JWTMakerType:
+		keys, err := ParseJWTKeys(config.TokenJWTKeys)
+		if err != nil {
+			return nil, err
+		}
+		return NewJWTMaker(keys, config.TokenJWTSigningKeyID)
+	default:
+		return nil, fmt.Errorf("unsupported token maker %q", config.TokenMaker)
+	}
+}
@@ -27,9 +27,9 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-// CreateToken creates a new token for a specific username and duration
-func (maker *PasetoMaker) CreateToken(email string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(email, duration)
+// CreateToken creates a new token for a specific email, role and duration
+func (maker *PasetoMaker) CreateToken(email string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, role, duration)
 	if err != nil {
 		return "", payload, err
 	}
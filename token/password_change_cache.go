@@ -0,0 +1,133 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/aalug/blog-go/utils"
+	"sync"
+	"time"
+)
+
+var ErrRevokedToken = errors.New("token was issued before the last password change")
+
+// maxPasswordChangeEntries is the number of cached users after which expired entries are removed
+const maxPasswordChangeEntries = 10000
+
+// PasswordChangeStore - source of the time of the last password change and of the current role of a user
+type PasswordChangeStore interface {
+	GetUserPasswordChangedAt(ctx context.Context, email string) (time.Time, error)
+	GetUserRole(ctx context.Context, email string) (string, error)
+}
+
+type passwordChangeEntry struct {
+	changedAt time.Time
+	role      string
+	expiresAt time.Time
+}
+
+// PasswordChangeCache checks tokens against the last password change and the current role of their users.
+// The times and the roles are cached for the given duration, so not every check reads the store.
+type PasswordChangeCache struct {
+	store    PasswordChangeStore
+	duration time.Duration
+	mu       sync.Mutex
+	entries  map[string]passwordChangeEntry
+}
+
+// NewPasswordChangeCache creates a new PasswordChangeCache. With a zero duration nothing is cached.
+func NewPasswordChangeCache(store PasswordChangeStore, duration time.Duration) *PasswordChangeCache {
+	return &PasswordChangeCache{
+		store:    store,
+		duration: duration,
+		entries:  make(map[string]passwordChangeEntry),
+	}
+}
+
+// Check returns ErrRevokedToken if the token was issued before the last password change
+// of its user and ErrInvalidToken if the user does not exist.
+// If the user has been demoted since the token was issued, the role of the payload
+// is lowered to the current role, so the token no longer grants the rights of the old role.
+// A promoted user gets the rights of the new role with the next token.
+func (cache *PasswordChangeCache) Check(ctx context.Context, payload *Payload) error {
+	entry, err := cache.entry(ctx, payload.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+
+	if payload.IssuedBefore(entry.changedAt) {
+		return ErrRevokedToken
+	}
+
+	if !utils.HasRole(entry.role, payload.Role) {
+		payload.Role = entry.role
+	}
+
+	return nil
+}
+
+// Invalidate removes the cached time and role of the user, so the next check reads them from the store.
+// It should be called after the password or the role of the user is changed.
+func (cache *PasswordChangeCache) Invalidate(email string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	delete(cache.entries, email)
+}
+
+// entry returns the cached time of the last password change and role of the user
+// or reads them from the store if they are not cached or expired
+func (cache *PasswordChangeCache) entry(ctx context.Context, email string) (passwordChangeEntry, error) {
+	now := time.Now()
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[email]
+	cache.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	changedAt, err := cache.store.GetUserPasswordChangedAt(ctx, email)
+	if err != nil {
+		return entry, err
+	}
+
+	role, err := cache.store.GetUserRole(ctx, email)
+	if err != nil {
+		return entry, err
+	}
+
+	entry = passwordChangeEntry{
+		changedAt: changedAt,
+		role:      role,
+		expiresAt: now.Add(cache.duration),
+	}
+
+	if cache.duration > 0 {
+		cache.mu.Lock()
+		if len(cache.entries) >= maxPasswordChangeEntries {
+			cache.removeExpired(now)
+		}
+		cache.entries[email] = entry
+		cache.mu.Unlock()
+	}
+
+	return entry, nil
+}
+
+// removeExpired removes expired entries. If none of them expired, all the entries are removed.
+// The caller must hold the lock.
+func (cache *PasswordChangeCache) removeExpired(now time.Time) {
+	for email, entry := range cache.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(cache.entries, email)
+		}
+	}
+
+	if len(cache.entries) >= maxPasswordChangeEntries {
+		cache.entries = make(map[string]passwordChangeEntry)
+	}
+}
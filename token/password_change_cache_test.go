@@ -0,0 +1,125 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// fakePasswordChangeStore counts the lookups of the password change times.
+// Users without a role in roles are authors.
+type fakePasswordChangeStore struct {
+	changedAt map[string]time.Time
+	roles     map[string]string
+	lookups   int
+}
+
+func (store *fakePasswordChangeStore) GetUserPasswordChangedAt(_ context.Context, email string) (time.Time, error) {
+	store.lookups++
+	changedAt, ok := store.changedAt[email]
+	if !ok {
+		return time.Time{}, sql.ErrNoRows
+	}
+	return changedAt, nil
+}
+
+func (store *fakePasswordChangeStore) GetUserRole(_ context.Context, email string) (string, error) {
+	role, ok := store.roles[email]
+	if !ok {
+		return utils.AuthorRole, nil
+	}
+	return role, nil
+}
+
+func TestPasswordChangeCache_Check(t *testing.T) {
+	email := utils.RandomEmail()
+	store := &fakePasswordChangeStore{
+		changedAt: map[string]time.Time{email: {}},
+	}
+	cache := NewPasswordChangeCache(store, time.Minute)
+
+	payload, err := NewPayload(email, utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+
+	// the time is read from the store only once
+	for i := 0; i < 3; i++ {
+		require.NoError(t, cache.Check(context.Background(), payload))
+	}
+	require.Equal(t, 1, store.lookups)
+
+	// the cached time is used until the entry is invalidated
+	changedAt := payload.IssuedAt.Add(time.Second)
+	store.changedAt[email] = changedAt
+	require.NoError(t, cache.Check(context.Background(), payload))
+
+	cache.Invalidate(email)
+	require.ErrorIs(t, cache.Check(context.Background(), payload), ErrRevokedToken)
+	require.Equal(t, 2, store.lookups)
+
+	// tokens issued after the password change are valid
+	newPayload, err := NewPayload(email, utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+	newPayload.IssuedAt = changedAt
+	require.NoError(t, cache.Check(context.Background(), newPayload))
+}
+
+func TestPasswordChangeCache_UserNotFound(t *testing.T) {
+	store := &fakePasswordChangeStore{changedAt: map[string]time.Time{}}
+	cache := NewPasswordChangeCache(store, time.Minute)
+
+	payload, err := NewPayload(utils.RandomEmail(), utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, cache.Check(context.Background(), payload), ErrInvalidToken)
+}
+
+func TestPasswordChangeCache_ZeroDuration(t *testing.T) {
+	email := utils.RandomEmail()
+	store := &fakePasswordChangeStore{
+		changedAt: map[string]time.Time{email: {}},
+	}
+	cache := NewPasswordChangeCache(store, 0)
+
+	payload, err := NewPayload(email, utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, cache.Check(context.Background(), payload))
+	}
+	require.Equal(t, 3, store.lookups)
+}
+
+func TestPasswordChangeCache_RoleChange(t *testing.T) {
+	email := utils.RandomEmail()
+	store := &fakePasswordChangeStore{
+		changedAt: map[string]time.Time{email: {}},
+		roles:     map[string]string{email: utils.AdminRole},
+	}
+	cache := NewPasswordChangeCache(store, time.Minute)
+
+	payload, err := NewPayload(email, utils.AdminRole, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, cache.Check(context.Background(), payload))
+	require.Equal(t, utils.AdminRole, payload.Role)
+
+	// the role of the token is lowered to the role of the demoted user
+	store.roles[email] = utils.ReaderRole
+	cache.Invalidate(email)
+
+	payload, err = NewPayload(email, utils.AdminRole, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, cache.Check(context.Background(), payload))
+	require.Equal(t, utils.ReaderRole, payload.Role)
+
+	// the token of the promoted user keeps its role until it is renewed
+	store.roles[email] = utils.ModeratorRole
+	cache.Invalidate(email)
+
+	payload, err = NewPayload(email, utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, cache.Check(context.Background(), payload))
+	require.Equal(t, utils.AuthorRole, payload.Role)
+}
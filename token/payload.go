@@ -13,22 +13,28 @@ var ErrInvalidToken = errors.New("token is invalid")
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
+	Role      string    `json:"role"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-// NewPayload creates a new token payload with a specific email and duration
-func NewPayload(email string, duration time.Duration) (*Payload, error) {
+// NewPayload creates a new token payload with a specific email, role and duration
+func NewPayload(email string, role string, duration time.Duration) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
 
+	// the database stores times with a microsecond precision,
+	// so the issue time can be compared with the time of the last password change
+	issuedAt := time.Now().Truncate(time.Microsecond)
+
 	payload := &Payload{
 		ID:        tokenID,
 		Email:     email,
-		IssuedAt:  time.Now(),
-		ExpiredAt: time.Now().Add(duration),
+		Role:      role,
+		IssuedAt:  issuedAt,
+		ExpiredAt: issuedAt.Add(duration),
 	}
 
 	return payload, nil
@@ -41,3 +47,9 @@ func (payload *Payload) Valid() error {
 	}
 	return nil
 }
+
+// IssuedBefore checks if the token was issued before the given time.
+// Both times have the microsecond precision of the database.
+func (payload *Payload) IssuedBefore(t time.Time) bool {
+	return payload.IssuedAt.Before(t)
+}
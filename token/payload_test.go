@@ -0,0 +1,19 @@
+package token
+
+import (
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPayload_IssuedBefore(t *testing.T) {
+	payload, err := NewPayload(utils.RandomEmail(), utils.AuthorRole, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, payload.IssuedAt, payload.IssuedAt.Truncate(time.Microsecond))
+
+	// a password change in the same second as the token, but after it, revokes the token
+	require.True(t, payload.IssuedBefore(payload.IssuedAt.Add(time.Microsecond)))
+	require.False(t, payload.IssuedBefore(payload.IssuedAt))
+	require.False(t, payload.IssuedBefore(payload.IssuedAt.Add(-time.Microsecond)))
+}
@@ -0,0 +1,94 @@
+// Package tracing sets up OpenTelemetry tracing and propagates trace context across processes
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/aalug/blog-go/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"io"
+	"os"
+)
+
+const ServiceName = "blog-go"
+
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// NewExporter creates the span exporter selected with TRACING_EXPORTER:
+// "otlp" exports to TRACING_OTLP_ENDPOINT over gRPC and "stdout" writes the spans to w as JSON.
+// Returns nil if tracing is disabled.
+func NewExporter(ctx context.Context, config utils.Config, w io.Writer) (sdktrace.SpanExporter, error) {
+	switch config.TracingExporter {
+	case ExporterNone:
+		return nil, nil
+	case ExporterOTLP:
+		var options []otlptracegrpc.Option
+		if config.TracingOTLPEndpoint != "" {
+			options = append(options, otlptracegrpc.WithEndpoint(config.TracingOTLPEndpoint))
+		}
+		if config.TracingOTLPInsecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, options...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(w))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", config.TracingExporter)
+	}
+}
+
+// Setup sets the W3C trace context propagator and, unless tracing is disabled, the global tracer provider
+// with the exporter from the configuration. The returned function flushes the remaining spans and stops the provider.
+func Setup(ctx context.Context, config utils.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := NewExporter(ctx, config, os.Stdout)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create tracing exporter: %w", err)
+	}
+
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Inject returns the trace context of ctx as a map, so it can be sent inside a message (e.g. a task payload)
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
+// Extract returns ctx with the trace context from the map created by Inject,
+// so the spans started with the returned context continue the trace
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
+}
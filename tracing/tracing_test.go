@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"github.com/aalug/blog-go/utils"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+func TestNewExporter(t *testing.T) {
+	exporter, err := NewExporter(context.Background(), utils.Config{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, exporter)
+
+	_, err = NewExporter(context.Background(), utils.Config{TracingExporter: "unknown"}, nil)
+	require.Error(t, err)
+}
+
+func TestStdoutExporter(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := NewExporter(context.Background(), utils.Config{TracingExporter: ExporterStdout}, &buf)
+	require.NoError(t, err)
+	require.NotNil(t, exporter)
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	_, span := provider.Tracer("test").Start(context.Background(), "test span")
+	span.End()
+	require.NoError(t, provider.Shutdown(context.Background()))
+
+	require.Contains(t, buf.String(), `"Name":"test span"`)
+	require.Contains(t, buf.String(), span.SpanContext().TraceID().String())
+}
+
+func TestInjectExtract(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// no trace context without a span
+	require.Nil(t, Inject(context.Background()))
+
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "parent")
+	defer span.End()
+
+	traceContext := Inject(ctx)
+	require.Contains(t, traceContext, "traceparent")
+
+	// the extracted context continues the trace, e.g. in the worker processing a task
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), traceContext))
+	require.True(t, extracted.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+}
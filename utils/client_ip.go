@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ParseTrustedProxies parses the IPs and CIDRs of the proxies whose x-forwarded-for header is trusted
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", proxy)
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", proxy)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// ClientIP returns the IP of the client that sent the request to the first trusted proxy.
+// The x-forwarded-for hops are read from the right, because each proxy appends the address
+// it received the request from, and only the hops appended by trusted proxies can be trusted.
+// Loopback addresses are always trusted.
+func ClientIP(remoteAddr string, forwardedFor []string, trustedProxies []*net.IPNet) string {
+	peerIP := NormalizeIP(remoteAddr)
+	if !isTrustedProxy(peerIP, trustedProxies) {
+		return peerIP
+	}
+
+	var hops []string
+	for _, header := range forwardedFor {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := NormalizeIP(hops[i])
+		if net.ParseIP(hop) == nil {
+			// the header was not set by a trusted proxy
+			return peerIP
+		}
+
+		if i == 0 || !isTrustedProxy(hop, trustedProxies) {
+			return hop
+		}
+	}
+
+	return peerIP
+}
+
+// NormalizeIP returns the IP of the address without the port and in its canonical form,
+// so the same client always has the same key
+func NormalizeIP(address string) string {
+	address = strings.TrimSpace(address)
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+
+	return address
+}
+
+// isTrustedProxy checks if the IP is a loopback address or one of the trusted proxies
+func isTrustedProxy(clientIP string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+
+	if ip.IsLoopback() {
+		return true
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
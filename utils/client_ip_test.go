@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.0.2.10 "})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		expected     string
+	}{
+		{"No Proxy", "198.51.100.1:1234", nil, "198.51.100.1"},
+		{"Untrusted Peer", "198.51.100.1:1234", []string{"203.0.113.1"}, "198.51.100.1"},
+		{"Trusted Peer", "127.0.0.1:1234", []string{"203.0.113.1"}, "203.0.113.1"},
+		{"Spoofed Header", "127.0.0.1:1234", []string{"203.0.113.1, 198.51.100.1"}, "198.51.100.1"},
+		{"Spoofed Headers", "[::1]:1234", []string{"203.0.113.1", "198.51.100.1"}, "198.51.100.1"},
+		{"Trusted Hops", "10.0.0.1:1234", []string{"203.0.113.1, 198.51.100.1, 192.0.2.10, 10.0.0.2"}, "198.51.100.1"},
+		{"Invalid Hop", "127.0.0.1:1234", []string{"198.51.100.1, unknown"}, "127.0.0.1"},
+		{"Only Trusted Hops", "127.0.0.1:1234", []string{"10.0.0.2"}, "10.0.0.2"},
+		{"No Header", "127.0.0.1:1234", nil, "127.0.0.1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, ClientIP(test.remoteAddr, test.forwardedFor, trustedProxies))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	_, err := ParseTrustedProxies([]string{"10.0.0.0/33"})
+	require.Error(t, err)
+
+	_, err = ParseTrustedProxies([]string{"proxy"})
+	require.Error(t, err)
+}
+
+func TestNormalizeIP(t *testing.T) {
+	require.Equal(t, "192.0.2.1", NormalizeIP("192.0.2.1:1234"))
+	require.Equal(t, "192.0.2.1", NormalizeIP(" 192.0.2.1 "))
+	require.Equal(t, "::1", NormalizeIP("[0:0::1]:1234"))
+	require.Equal(t, "bufconn", NormalizeIP("bufconn"))
+}
@@ -7,15 +7,67 @@ import (
 
 // Config stores configuration of the application
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	DBDriver                    string        `mapstructure:"DB_DRIVER"`
+	DBSource                    string        `mapstructure:"DB_SOURCE"`
+	MigrateOnStart              bool          `mapstructure:"MIGRATE_ON_START"`
+	HTTPServerAddress           string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	TrustedProxies              []string      `mapstructure:"TRUSTED_PROXIES"`
+	GRPCServerAddress           string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	GRPCTLSCertFile             string        `mapstructure:"GRPC_TLS_CERT_FILE"`
+	GRPCTLSKeyFile              string        `mapstructure:"GRPC_TLS_KEY_FILE"`
+	GatewayServerAddress        string        `mapstructure:"GATEWAY_SERVER_ADDRESS"`
+	GatewayGRPCEndpoint         string        `mapstructure:"GATEWAY_GRPC_ENDPOINT"`
+	GatewayGRPCTLS              bool          `mapstructure:"GATEWAY_GRPC_TLS"`
+	GatewayGRPCCAFile           string        `mapstructure:"GATEWAY_GRPC_CA_FILE"`
+	WorkerConcurrency           int           `mapstructure:"WORKER_CONCURRENCY"`
+	OutboxRelayInterval         time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	OpsServerAddress            string        `mapstructure:"OPS_SERVER_ADDRESS"`
+	ShutdownTimeout             time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	TaskShutdownTimeout         time.Duration `mapstructure:"TASK_SHUTDOWN_TIMEOUT"`
+	RedisAddress                string        `mapstructure:"REDIS_ADDRESS"`
+	TokenMaker                  string        `mapstructure:"TOKEN_MAKER"`
+	TokenSymmetricKey           string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenJWTKeys                []string      `mapstructure:"TOKEN_JWT_KEYS"`
+	TokenJWTSigningKeyID        string        `mapstructure:"TOKEN_JWT_SIGNING_KEY_ID"`
+	AccessTokenDuration         time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration        time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	PasswordChangeCacheDuration time.Duration `mapstructure:"PASSWORD_CHANGE_CACHE_DURATION"`
+	TwoFactorIssuer             string        `mapstructure:"TWO_FACTOR_ISSUER"`
+	OIDCIssuerURL               string        `mapstructure:"OIDC_ISSUER_URL"`
+	OIDCAuthURL                 string        `mapstructure:"OIDC_AUTH_URL"`
+	OIDCTokenURL                string        `mapstructure:"OIDC_TOKEN_URL"`
+	OIDCJWKSURL                 string        `mapstructure:"OIDC_JWKS_URL"`
+	OIDCClientID                string        `mapstructure:"OIDC_CLIENT_ID"`
+	OIDCClientSecret            string        `mapstructure:"OIDC_CLIENT_SECRET"`
+	OIDCRedirectURL             string        `mapstructure:"OIDC_REDIRECT_URL"`
+	LoginMaxAttempts            int           `mapstructure:"LOGIN_MAX_ATTEMPTS"`
+	LoginMaxAttemptsPerIP       int           `mapstructure:"LOGIN_MAX_ATTEMPTS_PER_IP"`
+	LoginAttemptWindow          time.Duration `mapstructure:"LOGIN_ATTEMPT_WINDOW"`
+	LoginLockoutDuration        time.Duration `mapstructure:"LOGIN_LOCKOUT_DURATION"`
+	LoginMaxLockoutDuration     time.Duration `mapstructure:"LOGIN_MAX_LOCKOUT_DURATION"`
+	RateLimitStore              string        `mapstructure:"RATE_LIMIT_STORE"`
+	RateLimitDefault            string        `mapstructure:"RATE_LIMIT_DEFAULT"`
+	RateLimits                  []string      `mapstructure:"RATE_LIMITS"`
+	TracingExporter             string        `mapstructure:"TRACING_EXPORTER"`
+	TracingOTLPEndpoint         string        `mapstructure:"TRACING_OTLP_ENDPOINT"`
+	TracingOTLPInsecure         bool          `mapstructure:"TRACING_OTLP_INSECURE"`
+	EmailSenderAddress          string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	CommentMaxLinks             int           `mapstructure:"COMMENT_MAX_LINKS"`
+	CommentBannedWords          []string      `mapstructure:"COMMENT_BANNED_WORDS"`
+	CommentHoldFirst            bool          `mapstructure:"COMMENT_HOLD_FIRST"`
+	CommentRateLimit            int           `mapstructure:"COMMENT_RATE_LIMIT"`
+	CommentRateWindow           time.Duration `mapstructure:"COMMENT_RATE_WINDOW"`
+}
+
+// CommentRules returns the comment moderation rules from the configuration
+func (config Config) CommentRules() CommentRules {
+	return CommentRules{
+		MaxLinks:         config.CommentMaxLinks,
+		BannedWords:      config.CommentBannedWords,
+		HoldFirstComment: config.CommentHoldFirst,
+		RateLimit:        config.CommentRateLimit,
+		RateWindow:       config.CommentRateWindow,
+	}
 }
 
 func LoadConfig(path string) (config Config, err error) {
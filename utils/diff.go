@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// PostDiffText renders the versioned fields of a post as one document, so two
+// versions can be compared with a single unified diff
+func PostDiffText(title, description, content string) string {
+	return fmt.Sprintf("# %s\n\n%s\n\n%s\n", title, description, content)
+}
+
+// UnifiedDiff returns the unified diff between two texts with 3 lines of context.
+// An empty string means that the texts are equal.
+func UnifiedDiff(fromName, from, toName, to string) (string, error) {
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	}
+	return difflib.GetUnifiedDiffString(diff)
+}
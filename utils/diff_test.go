@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	from := PostDiffText("title", "description", "first line\nsecond line")
+	to := PostDiffText("new title", "description", "first line\nchanged line")
+
+	diff, err := UnifiedDiff("revision 1", from, "revision 2", to)
+	require.NoError(t, err)
+	require.Contains(t, diff, "--- revision 1\n+++ revision 2\n")
+	require.Contains(t, diff, "-# title\n+# new title\n")
+	require.Contains(t, diff, "-second line\n+changed line\n")
+	require.Contains(t, diff, " first line\n")
+
+	diff, err = UnifiedDiff("revision 1", from, "revision 1", from)
+	require.NoError(t, err)
+	require.Empty(t, diff)
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Comment moderation statuses. They match the comment_status enum in the database.
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+	CommentStatusSpam     = "spam"
+)
+
+var (
+	linkPattern = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.`)
+	wordPattern = regexp.MustCompile(`[\p{L}\p{N}']+`)
+)
+
+// CommentRules decide whether a new comment goes live or into the moderation queue.
+// Zero values disable the rules.
+type CommentRules struct {
+	// MaxLinks is the number of links a comment can contain
+	MaxLinks int
+	// BannedWords are words that mark a comment as spam (case-insensitive)
+	BannedWords []string
+	// HoldFirstComment sends comments of users without any approved comment to the queue
+	HoldFirstComment bool
+	// RateLimit is the number of comments a user can post within RateWindow
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+// CommenterStats describes the comment history of a user
+type CommenterStats struct {
+	ApprovedComments int64
+	RecentComments   int64
+}
+
+// CheckContent checks only the content of the comment, without the history of its author.
+// It returns the status the comment should get and the reason of that status.
+func (rules CommentRules) CheckContent(content string) (status string, reason string) {
+	if rules.MaxLinks > 0 {
+		if links := len(linkPattern.FindAllStringIndex(content, -1)); links > rules.MaxLinks {
+			return CommentStatusSpam, fmt.Sprintf("too many links (%d, max %d)", links, rules.MaxLinks)
+		}
+	}
+
+	if word, ok := rules.findBannedWord(content); ok {
+		return CommentStatusSpam, fmt.Sprintf("contains banned word %q", word)
+	}
+
+	return CommentStatusApproved, ""
+}
+
+// Check checks the comment content and the history of its author.
+// It returns the status the comment should get and the reason of that status.
+func (rules CommentRules) Check(content string, stats CommenterStats) (status string, reason string) {
+	if status, reason = rules.CheckContent(content); status != CommentStatusApproved {
+		return status, reason
+	}
+
+	if rules.RateLimit > 0 && stats.RecentComments >= int64(rules.RateLimit) {
+		return CommentStatusPending, fmt.Sprintf("%d or more comments in %s", rules.RateLimit, rules.RateWindow)
+	}
+
+	if rules.HoldFirstComment && stats.ApprovedComments == 0 {
+		return CommentStatusPending, "first comment of the user"
+	}
+
+	return CommentStatusApproved, ""
+}
+
+// NeedsHistory reports whether any of the rules needs the comment history of the author
+func (rules CommentRules) NeedsHistory() bool {
+	return rules.HoldFirstComment || rules.RateLimit > 0
+}
+
+// findBannedWord returns the first banned word found in the content
+func (rules CommentRules) findBannedWord(content string) (string, bool) {
+	if len(rules.BannedWords) == 0 {
+		return "", false
+	}
+
+	banned := make(map[string]bool, len(rules.BannedWords))
+	for _, word := range rules.BannedWords {
+		if word = strings.TrimSpace(word); word != "" {
+			banned[strings.ToLower(word)] = true
+		}
+	}
+
+	for _, word := range wordPattern.FindAllString(content, -1) {
+		if word = strings.ToLower(word); banned[word] {
+			return word, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCommentRules_Check(t *testing.T) {
+	rules := CommentRules{
+		MaxLinks:         1,
+		BannedWords:      []string{"casino", " Pills "},
+		HoldFirstComment: true,
+		RateLimit:        3,
+		RateWindow:       time.Minute,
+	}
+	regular := CommenterStats{ApprovedComments: 5, RecentComments: 1}
+
+	testCases := []struct {
+		name    string
+		rules   CommentRules
+		content string
+		stats   CommenterStats
+		status  string
+	}{
+		{
+			name:    "Approved",
+			rules:   rules,
+			content: "Great post, see https://example.com for more",
+			stats:   regular,
+			status:  CommentStatusApproved,
+		},
+		{
+			name:    "Too Many Links",
+			rules:   rules,
+			content: "http://a.com and www.b.com",
+			stats:   regular,
+			status:  CommentStatusSpam,
+		},
+		{
+			name:    "Banned Word",
+			rules:   rules,
+			content: "Cheap PILLS here",
+			stats:   regular,
+			status:  CommentStatusSpam,
+		},
+		{
+			name:    "Banned Word As Part Of Another Word",
+			rules:   rules,
+			content: "casinos are not banned",
+			stats:   regular,
+			status:  CommentStatusApproved,
+		},
+		{
+			name:    "First Comment",
+			rules:   rules,
+			content: "hello",
+			stats:   CommenterStats{},
+			status:  CommentStatusPending,
+		},
+		{
+			name:    "Rate Limit",
+			rules:   rules,
+			content: "hello",
+			stats:   CommenterStats{ApprovedComments: 5, RecentComments: 3},
+			status:  CommentStatusPending,
+		},
+		{
+			name:    "Rules Disabled",
+			rules:   CommentRules{},
+			content: "http://a.com www.b.com casino",
+			stats:   CommenterStats{RecentComments: 100},
+			status:  CommentStatusApproved,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, reason := tc.rules.Check(tc.content, tc.stats)
+			require.Equal(t, tc.status, status)
+			if status == CommentStatusApproved {
+				require.Empty(t, reason)
+			} else {
+				require.NotEmpty(t, reason)
+			}
+		})
+	}
+
+	// the rate limit counts the comment that reaches the limit
+	_, reason := rules.Check("hello", CommenterStats{ApprovedComments: 5, RecentComments: 3})
+	require.Equal(t, "3 or more comments in 1m0s", reason)
+}
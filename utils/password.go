@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -18,4 +22,22 @@ func HashPassword(password string) (string, error) {
 // CheckPassword checks if password is correct
 func CheckPassword(password string, hashedPassword string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
-}
\ No newline at end of file
+}
+
+// HashResetPasswordCode returns the SHA-256 hash of the reset password code, which is stored
+// instead of the code. The codes are random, so like the recovery codes they do not need
+// a slow, salted hash, and the hash can be looked up directly.
+func HashResetPasswordCode(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+// RandomToken returns 32 cryptographically secure random bytes encoded with base64 (URL-safe, unpadded)
+func RandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate random token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -27,3 +27,26 @@ func TestPassword(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, hashedPassword1, hashedPassword2)
 }
+
+func TestHashResetPasswordCode(t *testing.T) {
+	code := RandomString(32)
+
+	hash := HashResetPasswordCode(code)
+	require.Len(t, hash, 64)
+	require.NotEqual(t, code, hash)
+
+	// the same code always has the same hash, so it can be looked up
+	require.Equal(t, hash, HashResetPasswordCode(code))
+	require.NotEqual(t, hash, HashResetPasswordCode(RandomString(32)))
+}
+
+func TestRandomToken(t *testing.T) {
+	token1, err := RandomToken()
+	require.NoError(t, err)
+	// 32 bytes encoded with unpadded base64
+	require.Len(t, token1, 43)
+
+	token2, err := RandomToken()
+	require.NoError(t, err)
+	require.NotEqual(t, token1, token2)
+}
@@ -0,0 +1,16 @@
+package utils
+
+// IsValidRequestID checks the request ID from a client, so it cannot flood the logs
+func IsValidRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
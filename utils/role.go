@@ -0,0 +1,33 @@
+package utils
+
+// User roles, from the least to the most privileged.
+// They match the user_role enum in the database.
+const (
+	ReaderRole    = "reader"
+	AuthorRole    = "author"
+	ModeratorRole = "moderator"
+	AdminRole     = "admin"
+)
+
+var roleRanks = map[string]int{
+	ReaderRole:    1,
+	AuthorRole:    2,
+	ModeratorRole: 3,
+	AdminRole:     4,
+}
+
+// IsSupportedRole check if the role is one of the user roles
+func IsSupportedRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// HasRole check if the role is at least as privileged as the required role.
+// Unknown roles never pass the check.
+func HasRole(role, required string) bool {
+	rank, ok := roleRanks[role]
+	if !ok {
+		return false
+	}
+	return rank >= roleRanks[required]
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHasRole(t *testing.T) {
+	tests := []struct {
+		role     string
+		required string
+		expected bool
+	}{
+		{AdminRole, AdminRole, true},
+		{AdminRole, ModeratorRole, true},
+		{ModeratorRole, AuthorRole, true},
+		{AuthorRole, ReaderRole, true},
+		{AuthorRole, ModeratorRole, false},
+		{ReaderRole, AuthorRole, false},
+		{ModeratorRole, AdminRole, false},
+		{"", ReaderRole, false},
+		{"superuser", ReaderRole, false},
+	}
+
+	for _, test := range tests {
+		result := HasRole(test.role, test.required)
+		require.Equal(t, test.expected, result)
+	}
+}
+
+func TestIsSupportedRole(t *testing.T) {
+	for _, role := range []string{ReaderRole, AuthorRole, ModeratorRole, AdminRole} {
+		require.True(t, IsSupportedRole(role))
+	}
+	require.False(t, IsSupportedRole("superuser"))
+	require.False(t, IsSupportedRole(""))
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"strings"
+	"time"
+)
+
+const (
+	// RecoveryCodesCount is the number of recovery codes generated at the two-factor enrolment
+	RecoveryCodesCount = 10
+	// MaxTwoFactorAttempts is the number of invalid codes after which a login challenge can no longer be used
+	MaxTwoFactorAttempts = 5
+	// recoveryCodeLength is the number of characters of a recovery code (without the separator)
+	recoveryCodeLength = 10
+	// totpPeriod is the number of seconds for which a TOTP code is valid
+	totpPeriod = 30
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPKey generates a new TOTP secret of the account and returns
+// the base32 encoded secret and the otpauth:// provisioning URI for authenticator apps
+func GenerateTOTPKey(issuer, accountName string) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: accountName,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate TOTP key: %w", err)
+	}
+
+	return key.Secret(), key.URL(), nil
+}
+
+// TOTPCodeStep checks if the code is valid for the secret at the given time and returns
+// the time step of the code (the number of the 30-second periods since the Unix epoch).
+// Codes of the previous and the next period are accepted as well to allow for clock drift,
+// so the step of an accepted code should be recorded to reject the code if it is replayed.
+func TOTPCodeStep(code, secret string, t time.Time) (int64, bool) {
+	for _, skew := range []int64{0, -1, 1} {
+		stepTime := t.Add(time.Duration(skew*totpPeriod) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret, stepTime, totp.ValidateOpts{
+			Period: totpPeriod,
+			Digits: otp.DigitsSix,
+		})
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(code), []byte(expected)) == 1 {
+			return stepTime.Unix() / totpPeriod, true
+		}
+	}
+
+	return 0, false
+}
+
+// IsTOTPCode checks if the code looks like a TOTP code (6 digits) rather than a recovery code
+func IsTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GenerateRecoveryCodes returns n random recovery codes in the "xxxxx-xxxxx" format
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	buf := make([]byte, recoveryCodeEncoding.DecodedLen(recoveryCodeLength)+1)
+
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(buf))[:recoveryCodeLength]
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+
+	return codes, nil
+}
+
+// HashRecoveryCode returns the SHA-256 hash of the recovery code.
+// Recovery codes are random, so unlike passwords they do not need a slow, salted hash,
+// and the hash can be looked up directly. Case and separators are ignored.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTOTP(t *testing.T) {
+	email := RandomEmail()
+
+	secret, uri, err := GenerateTOTPKey("blog-go", email)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/blog-go:"))
+	require.Contains(t, uri, "secret="+secret)
+
+	now := time.Now()
+	code, err := totp.GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok := TOTPCodeStep(code, secret, now)
+	require.True(t, ok)
+	require.Equal(t, now.Unix()/30, step)
+
+	// the code of the previous period is accepted because of clock drift,
+	// with the step of the code rather than the step of the time
+	driftStep, ok := TOTPCodeStep(code, secret, now.Add(30*time.Second))
+	require.True(t, ok)
+	require.Equal(t, step, driftStep)
+
+	_, ok = TOTPCodeStep(code, secret, now.Add(5*time.Minute))
+	require.False(t, ok)
+
+	otherSecret, _, err := GenerateTOTPKey("blog-go", email)
+	require.NoError(t, err)
+	_, ok = TOTPCodeStep(code, otherSecret, now)
+	require.False(t, ok)
+
+	_, ok = TOTPCodeStep("", secret, now)
+	require.False(t, ok)
+
+	require.True(t, IsTOTPCode(code))
+	require.False(t, IsTOTPCode("12345"))
+	require.False(t, IsTOTPCode("abcde-fghij"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(RecoveryCodesCount)
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodesCount)
+
+	hashes := make(map[string]bool)
+	for _, code := range codes {
+		require.Len(t, code, recoveryCodeLength+1)
+		require.Equal(t, byte('-'), code[recoveryCodeLength/2])
+
+		hash := HashRecoveryCode(code)
+		require.False(t, hashes[hash])
+		hashes[hash] = true
+
+		require.False(t, IsTOTPCode(code))
+
+		// case and separators are ignored
+		require.Equal(t, hash, HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+	}
+}
@@ -2,12 +2,18 @@ package validation
 
 import (
 	"fmt"
+	"github.com/aalug/blog-go/utils"
+	"github.com/google/uuid"
 	"net/mail"
 	"regexp"
+	"time"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	isValidUsername     = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	isValidCategoryName = regexp.MustCompile(`^[a-zA-Z]+$`).MatchString
+	isValidTOTPCode     = regexp.MustCompile(`^[0-9]{6}$`).MatchString
+	isValidRecoveryCode = regexp.MustCompile(`^[a-zA-Z2-7]{5}-?[a-zA-Z2-7]{5}$`).MatchString
 )
 
 // ValidateStringLength check if the string length is between minLength and maxLength
@@ -67,3 +73,242 @@ func ValidateVerifyEmailID(value int64) error {
 func ValidateVerifyEmailCode(value string) error {
 	return ValidateStringLength(value, 32, 128)
 }
+
+// ValidateID check if the id is a positive integer.
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+// ValidateRevision check if the post revision number is valid.
+// Revisions are numbered from 1.
+func ValidateRevision(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("revision must be greater than or equal to 1")
+	}
+	return nil
+}
+
+// ValidatePage check if the page number is valid.
+// It must be greater than or equal to 1.
+func ValidatePage(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("page must be greater than or equal to 1")
+	}
+	return nil
+}
+
+// ValidatePageSize check if the page size is valid.
+// It must be between 5 and 15.
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 15 {
+		return fmt.Errorf("page size must be between 5 and 15")
+	}
+	return nil
+}
+
+// ValidateCategoryName check if the category name is valid.
+// It must be between 2 and 50 characters long
+// and contain only letters.
+func ValidateCategoryName(value string) error {
+	if err := ValidateStringLength(value, 2, 50); err != nil {
+		return err
+	}
+
+	if !isValidCategoryName(value) {
+		return fmt.Errorf("category name is invalid, must contain only letters")
+	}
+
+	return nil
+}
+
+// ValidateTagName check if the tag name is valid.
+// It must be between 1 and 50 characters long.
+func ValidateTagName(value string) error {
+	return ValidateStringLength(value, 1, 50)
+}
+
+// ValidateTags check if every tag in the list is valid.
+// The list must contain at least one tag.
+func ValidateTags(values []string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("at least one tag is required")
+	}
+
+	for _, value := range values {
+		if err := ValidateTagName(value); err != nil {
+			return fmt.Errorf("tag %q is invalid: %w", value, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateSlug check if the slug is valid.
+// It must contain only letters, numbers and hyphens.
+func ValidateSlug(value string) error {
+	if err := ValidateStringLength(value, 1, 250); err != nil {
+		return err
+	}
+
+	if !utils.IsSlug(value) {
+		return fmt.Errorf("slug is invalid, must contain only letters, numbers and hyphens")
+	}
+
+	return nil
+}
+
+// ValidatePostTitle check if the post title is valid.
+// It must be between 1 and 250 characters long.
+func ValidatePostTitle(value string) error {
+	return ValidateStringLength(value, 1, 250)
+}
+
+// ValidatePostDescription check if the post description is valid.
+// It must be between 1 and 1000 characters long.
+func ValidatePostDescription(value string) error {
+	return ValidateStringLength(value, 1, 1000)
+}
+
+// ValidatePostContent check if the post content is not empty.
+func ValidatePostContent(value string) error {
+	if len(value) == 0 {
+		return fmt.Errorf("content cannot be empty")
+	}
+	return nil
+}
+
+// ValidateImage check if the image path is valid.
+// It must be between 1 and 500 characters long.
+func ValidateImage(value string) error {
+	return ValidateStringLength(value, 1, 500)
+}
+
+// ValidateCommentContent check if the comment content is valid.
+// It must be between 1 and 5000 characters long.
+func ValidateCommentContent(value string) error {
+	return ValidateStringLength(value, 1, 5000)
+}
+
+// ValidateSearchQuery check if the search query is valid.
+// It must be between 1 and 250 characters long.
+func ValidateSearchQuery(value string) error {
+	return ValidateStringLength(value, 1, 250)
+}
+
+// ValidatePostStatus check if the post status is one of the supported statuses.
+func ValidatePostStatus(value string) error {
+	switch value {
+	case "draft", "scheduled", "published", "archived":
+		return nil
+	}
+	return fmt.Errorf("status is invalid, must be one of: draft, scheduled, published, archived")
+}
+
+// ValidatePostSchedule check if the publish time fits the post status.
+// Scheduled posts need a publish time in the future and posts
+// with any other status cannot have one. Zero publishAt means it is not set.
+func ValidatePostSchedule(status string, publishAt time.Time) error {
+	if status == "scheduled" {
+		if publishAt.IsZero() {
+			return fmt.Errorf("publish_at is required for scheduled posts")
+		}
+		if !publishAt.After(time.Now()) {
+			return fmt.Errorf("publish_at must be in the future")
+		}
+		return nil
+	}
+
+	if !publishAt.IsZero() {
+		return fmt.Errorf("publish_at can only be set for scheduled posts")
+	}
+	return nil
+}
+
+// ValidateRole check if the role is one of the supported user roles.
+func ValidateRole(value string) error {
+	if !utils.IsSupportedRole(value) {
+		return fmt.Errorf("role is invalid, must be one of: reader, author, moderator, admin")
+	}
+	return nil
+}
+
+// ValidateModerationQueueStatus check if the status is one of the statuses of the moderation queue.
+func ValidateModerationQueueStatus(value string) error {
+	switch value {
+	case "pending", "spam", "rejected":
+		return nil
+	}
+	return fmt.Errorf("status is invalid, must be one of: pending, spam, rejected")
+}
+
+// ValidateModerationDecision check if the status can be set by a moderator.
+func ValidateModerationDecision(value string) error {
+	switch value {
+	case "approved", "rejected", "spam":
+		return nil
+	}
+	return fmt.Errorf("status is invalid, must be one of: approved, rejected, spam")
+}
+
+// ValidateIDs check if the list of ids is not empty, not too long and contains only valid ids.
+func ValidateIDs(values []int64, maxLength int) error {
+	if len(values) == 0 || len(values) > maxLength {
+		return fmt.Errorf("must contain from 1 to %d ids", maxLength)
+	}
+	for _, value := range values {
+		if err := ValidateID(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateUUID check if the value is a valid UUID.
+func ValidateUUID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+// ValidateRefreshToken check if the refresh token is not empty.
+func ValidateRefreshToken(value string) error {
+	if value == "" {
+		return fmt.Errorf("must not be empty")
+	}
+	return nil
+}
+
+// ValidateResetPasswordCode check if the reset password code has a valid length.
+func ValidateResetPasswordCode(value string) error {
+	return ValidateStringLength(value, 32, 128)
+}
+
+// ValidateTOTPCode check if the TOTP code consists of 6 digits.
+func ValidateTOTPCode(value string) error {
+	if !isValidTOTPCode(value) {
+		return fmt.Errorf("must consist of 6 digits")
+	}
+	return nil
+}
+
+// ValidateTwoFactorCode check if the code is either a TOTP code or a recovery code.
+func ValidateTwoFactorCode(value string) error {
+	if !isValidTOTPCode(value) && !isValidRecoveryCode(value) {
+		return fmt.Errorf("must be a 6-digit TOTP code or a recovery code")
+	}
+	return nil
+}
+
+// ValidateOIDCCode check if the authorization code from the OIDC provider has a valid length.
+func ValidateOIDCCode(value string) error {
+	return ValidateStringLength(value, 1, 2048)
+}
+
+// ValidateOIDCState check if the OIDC state has a valid length.
+func ValidateOIDCState(value string) error {
+	return ValidateStringLength(value, 32, 128)
+}
@@ -2,7 +2,11 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"github.com/aalug/blog-go/metrics"
 	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TaskDistributor interface {
@@ -11,6 +15,16 @@ type TaskDistributor interface {
 		payload *PayloadSendVerificationEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendResetPasswordEmail(
+		ctx context.Context,
+		payload *PayloadSendResetPasswordEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendLoginLockoutEmail(
+		ctx context.Context,
+		payload *PayloadSendLoginLockoutEmail,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -23,3 +37,24 @@ func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
 		client: client,
 	}
 }
+
+// enqueue enqueues the task and counts the enqueued tasks and the failures by the task type.
+// The failures are recorded in the span of distributing the task.
+func enqueue(ctx context.Context, client *asynq.Client, task *asynq.Task) (*asynq.TaskInfo, error) {
+	info, err := client.EnqueueContext(ctx, task)
+	if err != nil {
+		// the task has already been published from the outbox, which is not a failure
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return nil, err
+		}
+
+		metrics.TasksEnqueueFailuresTotal.WithLabelValues(task.Type()).Inc()
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	metrics.TasksEnqueuedTotal.WithLabelValues(task.Type()).Inc()
+	return info, nil
+}
@@ -0,0 +1,218 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/tracing"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	// outboxBatchSize is the max number of messages published in one transaction
+	outboxBatchSize = 100
+
+	// outboxTaskRetention is how long asynq keeps the tasks published from the outbox
+	// after they are processed and how long the published messages stay in the outbox.
+	// A message published again within it is ignored by asynq, as its task ID already exists.
+	outboxTaskRetention = 24 * time.Hour
+
+	// defaultOutboxRelayInterval is used when OUTBOX_RELAY_INTERVAL is not set
+	defaultOutboxRelayInterval = time.Second
+
+	// outboxMaxAttempts is the number of failed attempts after which a message is dead.
+	// With outboxRetryDelay, the last attempt is made about 8 hours after the first one.
+	outboxMaxAttempts = 20
+
+	// outboxMaxRetryDelay is the max delay between the attempts to publish a message
+	outboxMaxRetryDelay = time.Hour
+)
+
+// outboxRetryDelay returns the delay of the next attempt to publish a message that has failed
+// attempts times - it doubles with every attempt, starting at 1s, up to outboxMaxRetryDelay
+func outboxRetryDelay(attempts int32) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 13 {
+		return outboxMaxRetryDelay
+	}
+
+	delay := time.Second << (attempts - 1)
+	if delay > outboxMaxRetryDelay {
+		return outboxMaxRetryDelay
+	}
+
+	return delay
+}
+
+// OutboxOptions - the options of a task written to the outbox
+type OutboxOptions struct {
+	Queue     string
+	MaxRetry  int
+	ProcessIn time.Duration
+}
+
+// newOutboxMessage returns the outbox message of the task with the payload
+func newOutboxMessage(taskType string, payload interface{}, opts OutboxOptions) (db.CreateOutboxMessageParams, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return db.CreateOutboxMessageParams{}, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	queue := opts.Queue
+	if queue == "" {
+		queue = QueueDefault
+	}
+
+	message := db.CreateOutboxMessageParams{
+		TaskType:  taskType,
+		Payload:   jsonPayload,
+		Queue:     queue,
+		MaxRetry:  int32(opts.MaxRetry),
+		ProcessAt: time.Now().Add(opts.ProcessIn),
+	}
+
+	return message, nil
+}
+
+// OutboxTaskID returns the ID of the task published from the outbox message.
+// asynq rejects a task with the ID of an existing task, which de-duplicates the messages published more than once.
+func OutboxTaskID(messageID int64) string {
+	return fmt.Sprintf("outbox:%d", messageID)
+}
+
+type OutboxRelay interface {
+	Start() error
+	Shutdown()
+}
+
+// RedisOutboxRelay publishes the pending outbox messages to asynq, at least once
+type RedisOutboxRelay struct {
+	client   *asynq.Client
+	store    db.Store
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewRedisOutboxRelay creates a new outbox relay, which checks the outbox every interval
+func NewRedisOutboxRelay(redisOpt asynq.RedisClientOpt, store db.Store, interval time.Duration) OutboxRelay {
+	if interval <= 0 {
+		interval = defaultOutboxRelayInterval
+	}
+
+	return &RedisOutboxRelay{
+		client:   asynq.NewClient(redisOpt),
+		store:    store,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start starts relaying the messages in the background
+func (relay *RedisOutboxRelay) Start() error {
+	go func() {
+		defer close(relay.done)
+
+		ticker := time.NewTicker(relay.interval)
+		defer ticker.Stop()
+
+		for {
+			relay.relay(context.Background())
+
+			select {
+			case <-relay.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown waits for the batch in progress and stops the relay
+func (relay *RedisOutboxRelay) Shutdown() {
+	close(relay.stop)
+	<-relay.done
+
+	err := relay.client.Close()
+	if err != nil {
+		log.Error().Err(err).Msg("cannot close the outbox relay client")
+	}
+}
+
+// relay publishes the pending messages in batches until none is left or publishing fails,
+// then deletes the old published messages. The failed messages are retried after outboxRetryDelay
+// and do not block the messages after them.
+func (relay *RedisOutboxRelay) relay(ctx context.Context) {
+	for {
+		result, err := relay.store.RelayOutboxTx(ctx, db.RelayOutboxTxParams{
+			Limit:       outboxBatchSize,
+			MaxAttempts: outboxMaxAttempts,
+			RetryDelay:  outboxRetryDelay,
+			Publish: func(message db.OutboxMessage) error {
+				return relay.publish(ctx, message)
+			},
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("cannot relay the outbox")
+			return
+		}
+		if result.Dead > 0 {
+			log.Error().Int("dead", result.Dead).Int("max_attempts", outboxMaxAttempts).
+				Msg("outbox messages are dead after too many failed attempts")
+		}
+		if result.Err != nil {
+			// the rest of the messages is published in the next run, e.g. after the broker is back
+			log.Error().Err(result.Err).Int("published", result.Published).Int("failed", result.Failed).
+				Msg("cannot publish outbox message")
+			return
+		}
+		if result.Published < outboxBatchSize {
+			break
+		}
+	}
+
+	_, err := relay.store.DeletePublishedOutboxMessages(ctx, time.Now().Add(-outboxTaskRetention))
+	if err != nil {
+		log.Error().Err(err).Msg("cannot delete published outbox messages")
+	}
+}
+
+// publish enqueues the task of the message. The task continues the trace of the request
+// that wrote the message, if its payload has one.
+func (relay *RedisOutboxRelay) publish(ctx context.Context, message db.OutboxMessage) error {
+	task := asynq.NewTask(
+		message.TaskType,
+		message.Payload,
+		asynq.TaskID(OutboxTaskID(message.ID)),
+		asynq.Queue(message.Queue),
+		asynq.MaxRetry(int(message.MaxRetry)),
+		asynq.ProcessAt(message.ProcessAt),
+		asynq.Retention(outboxTaskRetention),
+	)
+
+	ctx = tracing.Extract(ctx, traceContextOf(task))
+	ctx, span := startEnqueueSpan(ctx, task.Type())
+	defer span.End()
+
+	info, err := enqueue(ctx, relay.client, task)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Info().Str("type", task.Type()).Int64("outbox_id", message.ID).Msg("outbox message already published")
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+	log.Info().Str("type", task.Type()).Int64("outbox_id", message.ID).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
@@ -2,10 +2,13 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/mail"
+	"github.com/aalug/blog-go/metrics"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
+	"time"
 )
 
 const (
@@ -15,7 +18,11 @@ const (
 
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendVerificationEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendResetPasswordEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendLoginLockoutEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPublishScheduledPosts(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
@@ -24,10 +31,20 @@ type RedisTaskProcessor struct {
 	emailSender mail.EmailSender
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, emailSender mail.EmailSender) TaskProcessor {
+// NewRedisTaskProcessor creates a new task processor, which processes up to concurrency tasks
+// at the same time (the number of CPUs if 0).
+// On Shutdown, the tasks in progress get shutdownTimeout to finish, then they are requeued.
+func NewRedisTaskProcessor(
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+	emailSender mail.EmailSender,
+	concurrency int,
+	shutdownTimeout time.Duration,
+) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
+			Concurrency: concurrency,
 			Queues: map[string]int{
 				QueueCritical: 10,
 				QueueDefault:  5,
@@ -37,9 +54,11 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, emailS
 					// log error
 					log.Error().Err(err).Str("type", task.Type()).
 						Bytes("payload", task.Payload()).
+						Str("request_id", requestIDOf(task)).
 						Msg("process task failed")
 				}),
-			Logger: NewLogger(),
+			Logger:          NewLogger(),
+			ShutdownTimeout: shutdownTimeout,
 		},
 	)
 
@@ -53,8 +72,41 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, emailS
 // Start starts the processor
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
+	mux.Use(tracingMiddleware, metricsMiddleware)
 
 	mux.HandleFunc(TaskSendVerificationEmail, processor.ProcessTaskSendVerificationEmail)
+	mux.HandleFunc(TaskSendResetPasswordEmail, processor.ProcessTaskSendResetPasswordEmail)
+	mux.HandleFunc(TaskSendLoginLockoutEmail, processor.ProcessTaskSendLoginLockoutEmail)
+	mux.HandleFunc(TaskPublishScheduledPosts, processor.ProcessTaskPublishScheduledPosts)
 
 	return processor.server.Start(mux)
 }
+
+// Shutdown stops processing new tasks and waits for the tasks in progress
+func (processor *RedisTaskProcessor) Shutdown() {
+	processor.server.Shutdown()
+}
+
+// metricsMiddleware counts the processed tasks and the failed attempts by the task type
+func metricsMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		err := handler.ProcessTask(ctx, task)
+		if err != nil {
+			metrics.TasksFailedTotal.WithLabelValues(task.Type()).Inc()
+			return err
+		}
+
+		metrics.TasksProcessedTotal.WithLabelValues(task.Type()).Inc()
+		return nil
+	})
+}
+
+// requestIDOf returns the ID of the request that distributed the task, if its payload has one
+func requestIDOf(task *asynq.Task) string {
+	var payload struct {
+		RequestID string `json:"request_id"`
+	}
+	_ = json.Unmarshal(task.Payload(), &payload)
+
+	return payload.RequestID
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// publishScheduledPostsInterval is how often scheduled posts are checked
+// and published once their publish time has passed.
+const publishScheduledPostsInterval = "@every 1m"
+
+type TaskScheduler interface {
+	Start() error
+	Shutdown()
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			Logger: NewLogger(),
+		},
+	)
+
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}
+}
+
+// Start registers the periodic tasks and starts the scheduler
+func (scheduler *RedisTaskScheduler) Start() error {
+	task := asynq.NewTask(TaskPublishScheduledPosts, nil)
+	entryID, err := scheduler.scheduler.Register(
+		publishScheduledPostsInterval,
+		task,
+		asynq.Queue(QueueDefault),
+		// a later run publishes whatever this one missed
+		asynq.MaxRetry(0),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register periodic task: %w", err)
+	}
+	log.Info().Str("type", task.Type()).Str("entry_id", entryID).
+		Str("cron", publishScheduledPostsInterval).Msg("registered periodic task")
+
+	return scheduler.scheduler.Start()
+}
+
+// Shutdown stops the scheduler
+func (scheduler *RedisTaskScheduler) Shutdown() {
+	scheduler.scheduler.Shutdown()
+}
@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskPublishScheduledPosts = "task:publish_scheduled_posts"
+
+// ProcessTaskPublishScheduledPosts processes the task of publishing
+// scheduled posts whose publish time has passed.
+func (processor *RedisTaskProcessor) ProcessTaskPublishScheduledPosts(ctx context.Context, task *asynq.Task) error {
+	ids, err := processor.store.PublishScheduledPosts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to publish scheduled posts: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Ints64("post_ids", ids).Msg("processed task")
+
+	return nil
+}
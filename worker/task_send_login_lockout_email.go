@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/aalug/blog-go/mail"
+	"github.com/aalug/blog-go/tracing"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const TaskSendLoginLockoutEmail = "task:send_login_lockout_email"
+
+type PayloadSendLoginLockoutEmail struct {
+	Email        string            `json:"email"`
+	ClientIP     string            `json:"client_ip"`
+	LockedUntil  time.Time         `json:"locked_until"`
+	RequestID    string            `json:"request_id,omitempty"`
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// DistributeTaskSendLoginLockoutEmail distributes the task of sending an email
+// about the account locked after too many failed login attempts.
+func (distributor *RedisTaskDistributor) DistributeTaskSendLoginLockoutEmail(
+	ctx context.Context,
+	payload *PayloadSendLoginLockoutEmail,
+	opts ...asynq.Option,
+) error {
+	ctx, span := startEnqueueSpan(ctx, TaskSendLoginLockoutEmail)
+	defer span.End()
+
+	payload.TraceContext = tracing.Inject(ctx)
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendLoginLockoutEmail, jsonPayload, opts...)
+	info, err := enqueue(ctx, distributor.client, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendLoginLockoutEmail processes the task of sending an email
+// about the account locked after too many failed login attempts.
+func (processor *RedisTaskProcessor) ProcessTaskSendLoginLockoutEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendLoginLockoutEmail
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("user does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// send email to user
+	content := fmt.Sprintf(`
+		<h3>Hello %s</h3><br>
+		<p class="message">
+		There were too many failed attempts to log in to your account, the last one from %s.
+		Logging in to your account is locked until %s.
+		</p>
+		<p class="message">
+		If it was not you, someone may be trying to guess your password.
+		Consider changing it and enabling the two-factor authentication.
+		</p>
+		`, user.Username, payload.ClientIP, payload.LockedUntil.UTC().Format(time.RFC1123))
+	err = processor.emailSender.SendEmail(mail.Data{
+		To:       []string{user.Email},
+		Subject:  "Your Blog Go account has been locked",
+		Content:  content,
+		Template: "verification_email.html",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Str("request_id", payload.RequestID).Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	db "github.com/aalug/blog-go/db/sqlc"
+	"github.com/aalug/blog-go/mail"
+	"github.com/aalug/blog-go/tracing"
+	"github.com/aalug/blog-go/utils"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendResetPasswordEmail = "task:send_reset_password_email"
+
+type PayloadSendResetPasswordEmail struct {
+	Email        string            `json:"email"`
+	RequestID    string            `json:"request_id,omitempty"`
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// DistributeTaskSendResetPasswordEmail distributes the task of sending a reset password email.
+func (distributor *RedisTaskDistributor) DistributeTaskSendResetPasswordEmail(
+	ctx context.Context,
+	payload *PayloadSendResetPasswordEmail,
+	opts ...asynq.Option,
+) error {
+	ctx, span := startEnqueueSpan(ctx, TaskSendResetPasswordEmail)
+	defer span.End()
+
+	payload.TraceContext = tracing.Inject(ctx)
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendResetPasswordEmail, jsonPayload, opts...)
+	info, err := enqueue(ctx, distributor.client, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendResetPasswordEmail processes the task of sending a reset password email.
+// It creates a single-use reset code and sends it to the user.
+func (processor *RedisTaskProcessor) ProcessTaskSendResetPasswordEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendResetPasswordEmail
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("user does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	code, err := utils.RandomToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate reset password code: %w", err)
+	}
+
+	// create reset password code in the database, only its hash is stored
+	_, err = processor.store.CreateResetPassword(ctx, db.CreateResetPasswordParams{
+		Email:      user.Email,
+		SecretCode: utils.HashResetPasswordCode(code),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create reset password: %w", err)
+	}
+
+	// send email to user
+	content := fmt.Sprintf(`
+		<h3>Hello %s</h3><br>
+		<p class="message">
+		We received a request to reset your password. Use the code below to set a new one.
+		The code is valid for 15 minutes and can be used only once:
+		</p>
+		<p class="message"><b>%s</b></p>
+		`, user.Username, code)
+	err = processor.emailSender.SendEmail(mail.Data{
+		To:       []string{user.Email},
+		Subject:  "Reset your Blog Go password",
+		Content:  content,
+		Template: "reset_password_email.html",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Str("request_id", payload.RequestID).Msg("processed task")
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	db "github.com/aalug/blog-go/db/sqlc"
 	"github.com/aalug/blog-go/mail"
+	"github.com/aalug/blog-go/tracing"
 	"github.com/aalug/blog-go/utils"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
@@ -14,7 +15,9 @@ import (
 const TaskSendVerificationEmail = "task:send_verification_email"
 
 type PayloadSendVerificationEmail struct {
-	Email string `json:"email"`
+	Email        string            `json:"email"`
+	RequestID    string            `json:"request_id,omitempty"`
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // DistributeTaskSendVerificationEmail distributes the task of sending a verification email.
@@ -23,13 +26,17 @@ func (distributor *RedisTaskDistributor) DistributeTaskSendVerificationEmail(
 	payload *PayloadSendVerificationEmail,
 	opts ...asynq.Option,
 ) error {
+	ctx, span := startEnqueueSpan(ctx, TaskSendVerificationEmail)
+	defer span.End()
+
+	payload.TraceContext = tracing.Inject(ctx)
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
 	task := asynq.NewTask(TaskSendVerificationEmail, jsonPayload, opts...)
-	info, err := distributor.client.EnqueueContext(ctx, task)
+	info, err := enqueue(ctx, distributor.client, task)
 	if err != nil {
 		return fmt.Errorf("failed to enqueue task: %w", err)
 	}
@@ -39,6 +46,17 @@ func (distributor *RedisTaskDistributor) DistributeTaskSendVerificationEmail(
 	return nil
 }
 
+// OutboxTaskSendVerificationEmail returns the outbox message of the task of sending a verification email.
+// The task is enqueued by the outbox relay once the transaction that writes the message commits.
+func OutboxTaskSendVerificationEmail(
+	ctx context.Context,
+	payload *PayloadSendVerificationEmail,
+	opts OutboxOptions,
+) (db.CreateOutboxMessageParams, error) {
+	payload.TraceContext = tracing.Inject(ctx)
+	return newOutboxMessage(TaskSendVerificationEmail, payload, opts)
+}
+
 // ProcessTaskSendVerificationEmail processes the task of sending a verification email.
 func (processor *RedisTaskProcessor) ProcessTaskSendVerificationEmail(ctx context.Context, task *asynq.Task) error {
 	var payload PayloadSendVerificationEmail
@@ -81,7 +99,7 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerificationEmail(ctx contex
 	}
 
 	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
-		Str("email", user.Email).Msg("processed task")
+		Str("email", user.Email).Str("request_id", payload.RequestID).Msg("processed task")
 
 	return nil
 }
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/aalug/blog-go/tracing"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/aalug/blog-go/worker")
+
+// startEnqueueSpan starts the span of distributing a task of the type.
+// The trace context of the returned context is set in the task payload,
+// so the span of processing the task continues the trace.
+func startEnqueueSpan(ctx context.Context, taskType string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "enqueue "+taskType, trace.WithSpanKind(trace.SpanKindProducer))
+}
+
+// tracingMiddleware records a span of processing a task, which continues
+// the trace of the request that distributed the task, if its payload has one
+func tracingMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		ctx = tracing.Extract(ctx, traceContextOf(task))
+		ctx, span := tracer.Start(ctx, "process "+task.Type(), trace.WithSpanKind(trace.SpanKindConsumer))
+		defer span.End()
+
+		err := handler.ProcessTask(ctx, task)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return err
+	})
+}
+
+// traceContextOf returns the trace context of the request that distributed the task, if its payload has one
+func traceContextOf(task *asynq.Task) map[string]string {
+	var payload struct {
+		TraceContext map[string]string `json:"trace_context"`
+	}
+	_ = json.Unmarshal(task.Payload(), &payload)
+
+	return payload.TraceContext
+}